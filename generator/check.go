@@ -0,0 +1,319 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Kind kind of Finding
+type Kind string
+
+// kinds of Finding in the order reported by Check
+const (
+	KindMissing         Kind = "missing"          // constant without key-value pair in TOML of a locale
+	KindMissingFragment Kind = "missing-fragment" // placeholder fragment without key-value pair in TOML of a locale
+	KindUnused          Kind = "unused"           // key-value pair in TOML without constant, can be deleted
+	KindDuplicate       Kind = "duplicate"        // key defined again in TOML of a locale, the last one is used
+	KindEmpty           Kind = "empty"            // key-value pair of a constant with an empty value
+	KindPlaceholder     Kind = "placeholder"      // fmt verbs of a translation differ from the default locale
+	KindFragment        Kind = "fragment"         // placeholder fragment constant, translation only
+)
+
+// Severity how a kind of Finding is reported, named as the levels of SARIF
+type Severity string
+
+// severities of kinds of Finding
+const (
+	SeverityError   Severity = "error"   // fails -check -strict
+	SeverityWarning Severity = "warning" // reported only
+	SeverityNote    Severity = "note"    // for information
+	SeverityOff     Severity = "off"     // not reported by Check
+)
+
+// kinds all kinds of Finding in the order reported by Check, with their default severity
+var kinds = []struct {
+	kind     Kind
+	severity Severity
+	text     string
+}{
+	{KindMissing, SeverityError, "Constant without key-value pair in TOML of a locale"},
+	{KindMissingFragment, SeverityError, "Placeholder fragment without key-value pair in TOML of a locale"},
+	{KindUnused, SeverityWarning, "Key-value pair in TOML not used by any constant"},
+	{KindDuplicate, SeverityWarning, "Key defined more than once in TOML of a locale"},
+	{KindEmpty, SeverityWarning, "Key-value pair of a constant with an empty value"},
+	{KindPlaceholder, SeverityError, "Translation with fmt verbs different from the default locale"},
+	{KindFragment, SeverityNote, "Placeholder fragment constant, translation only"},
+}
+
+// ParseSeverities parses comma-separated kind=severity pairs such as unused=error,fragment=off,
+// the value of Config.Severities, kinds not listed keep their default severity:
+//  - missing, missing-fragment, placeholder: error
+//  - unused, duplicate, empty: warning
+//  - fragment: note
+func ParseSeverities(s string) (map[Kind]Severity, error) {
+	res := make(map[Kind]Severity)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		idx := strings.Index(item, "=")
+		if idx < 0 {
+			return nil, fmt.Errorf("severity `%s` must be kind=severity", item)
+		}
+		kind, severity := Kind(strings.TrimSpace(item[:idx])), Severity(strings.TrimSpace(item[idx+1:]))
+		if err := validKind(kind); err != nil {
+			return nil, err
+		}
+		if err := validSeverity(kind, severity); err != nil {
+			return nil, err
+		}
+		res[kind] = severity
+	}
+	return res, nil
+}
+
+// validSeverity reports an error when severity is unknown
+func validSeverity(kind Kind, severity Severity) error {
+	switch severity {
+	case SeverityError, SeverityWarning, SeverityNote, SeverityOff:
+		return nil
+	}
+	return fmt.Errorf("severity `%s` of kind `%s` is unknown, only error, warning, note or off supported", severity, kind)
+}
+
+// validKind reports an error when kind is unknown
+func validKind(kind Kind) error {
+	names := make([]string, 0, len(kinds))
+	for _, item := range kinds {
+		if item.kind == kind {
+			return nil
+		}
+		names = append(names, string(item.kind))
+	}
+	return fmt.Errorf("kind `%s` is unknown, only %s supported", kind, strings.Join(names, ", "))
+}
+
+// Finding one missing, useless or suspicious key-value pair in TOML found by Check
+type Finding struct {
+	Kind     Kind           // kind of the finding
+	Severity Severity       // severity of the kind, by Config.Severities or the default
+	Allowed  bool           // known gap matched by the allowlist of Config.Allowlist
+	Package  string         // import path of the package
+	Type     string         // type name of the constant, empty for KindUnused and KindDuplicate
+	Const    string         // constant name, empty for KindUnused and KindDuplicate
+	Locale   string         // locale of the TOML, empty for KindFragment
+	Key      string         // TOML key
+	Note     string         // note for translators set by //i18n:note "..."
+	Detail   string         // fmt verbs of the translation and the default locale for KindPlaceholder
+	Pos      token.Position // the constant for KindMissing, KindMissingFragment and KindFragment, otherwise the TOML; absolute file path
+}
+
+// Message describes the finding in one sentence
+func (f Finding) Message() string {
+	var msg string
+	switch f.Kind {
+	case KindMissing:
+		msg = fmt.Sprintf("constant %s of type %s has no key-value pair %s in TOML of locale %s", f.Const, f.Type, f.Key, f.Locale)
+	case KindMissingFragment:
+		msg = fmt.Sprintf("placeholder fragment %s of type %s has no key-value pair %s in TOML of locale %s", f.Const, f.Type, f.Key, f.Locale)
+	case KindUnused:
+		msg = fmt.Sprintf("key-value pair %s in TOML of locale %s is not used by any constant", f.Key, f.Locale)
+	case KindDuplicate:
+		msg = fmt.Sprintf("key %s is defined again in TOML of locale %s, only the last one is used", f.Key, f.Locale)
+	case KindEmpty:
+		msg = fmt.Sprintf("key-value pair %s of constant %s of type %s in TOML of locale %s has an empty value", f.Key, f.Const, f.Type, f.Locale)
+	case KindPlaceholder:
+		msg = fmt.Sprintf("translation %s of constant %s of type %s in TOML of locale %s has %s", f.Key, f.Const, f.Type, f.Locale, f.Detail)
+	case KindFragment:
+		msg = fmt.Sprintf("constant %s of type %s is a placeholder fragment, translation only", f.Const, f.Type)
+	}
+	if f.Note != "" {
+		msg += ", note: " + f.Note
+	}
+	return msg
+}
+
+// Check returns the missing, useless and suspicious key-value pairs in the TOML files of p, and its
+// placeholder fragment constants. Findings are ordered by package, type, locale, key, constant and
+// then kind in the order of the kinds table, so that reports of the same code and TOML files are identical.
+// Kinds of SeverityOff are left out, known gaps of Config.Allowlist are marked Allowed.
+// The pseudo locale of Config.Pseudo is not checked.
+func Check(p *Package) []Finding {
+	g := p.g
+	var findings []Finding
+	for _, typeName := range p.Types {
+		for _, locale := range g.parser.locales {
+			if locale == g.parser.pseudo {
+				continue // generated, not translated
+			}
+			for _, value := range g.values[typeName] {
+				if g.parser.HasLocaleValue(value.key, locale) {
+					item := Finding{Kind: KindEmpty, Package: p.Path, Type: typeName, Const: value.originalName,
+						Locale: locale, Key: value.key, Note: value.note, Pos: g.parser.positions[locale][value.key]}
+					if g.parser.GetLocaleValue(value.key, locale) == "" {
+						findings = append(findings, item)
+					} else if detail := g.verbsMismatch(value, locale); detail != "" {
+						item.Kind, item.Detail = KindPlaceholder, detail
+						findings = append(findings, item)
+					}
+					continue
+				}
+				if value.comment != "" && locale == g.defaultLocale {
+					continue
+				}
+				item := Finding{Kind: KindMissing, Package: p.Path, Type: typeName, Const: value.originalName,
+					Locale: locale, Key: value.key, Note: value.note, Pos: value.pos}
+				if value.fragment {
+					item.Kind = KindMissingFragment
+				}
+				findings = append(findings, item)
+			}
+		}
+		for _, value := range g.values[typeName] {
+			if value.fragment {
+				findings = append(findings, Finding{Kind: KindFragment, Package: p.Path, Type: typeName,
+					Const: value.originalName, Key: value.key, Note: value.note, Pos: value.pos})
+			}
+		}
+	}
+
+	for _, item := range g.parser.duplicates {
+		findings = append(findings, Finding{Kind: KindDuplicate, Package: p.Path, Locale: item.locale, Key: item.key,
+			Pos: item.pos})
+	}
+	findings = append(findings, p.unused()...)
+	return p.classify(sortFindings(findings))
+}
+
+// classify sets the severity of findings by Config.Severities and marks the known gaps of
+// the allowlist, findings of SeverityOff are dropped
+func (p *Package) classify(findings []Finding) []Finding {
+	severities := make(map[Kind]Severity, len(kinds))
+	for _, item := range kinds {
+		severities[item.kind] = item.severity
+	}
+	for kind, severity := range p.cfg.Severities {
+		severities[kind] = severity
+	}
+	res := findings[:0]
+	for _, item := range findings {
+		item.Severity = severities[item.Kind]
+		if item.Severity == SeverityOff {
+			continue
+		}
+		item.Allowed = p.allowed(item)
+		res = append(res, item)
+	}
+	return res
+}
+
+// allowed reports whether the finding is a known gap of the allowlist
+func (p *Package) allowed(f Finding) bool {
+	for _, rule := range p.allow {
+		if rule.match(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// unused returns the key-value pairs in the TOML files of p not used by any constant of its types
+// as findings of KindUnused ordered by locale and key, whatever the severity of the kind
+func (p *Package) unused() []Finding {
+	g := p.g
+
+	// keys of all types, a key not used by any of them can be deleted
+	used := make(map[string]bool)
+	for _, values := range g.values {
+		for _, value := range values {
+			used[value.key] = true
+		}
+	}
+	var unused []Finding
+	for _, locale := range g.parser.locales {
+		if locale == g.parser.pseudo {
+			continue
+		}
+		for key := range g.parser.localesMap[locale] {
+			if !used[key] {
+				unused = append(unused, Finding{Kind: KindUnused, Package: p.Path, Locale: locale, Key: key,
+					Pos: g.parser.positions[locale][key]})
+			}
+		}
+	}
+	return unused
+}
+
+// verbsMismatch describes the fmt verbs of the translation of value in locale and the default
+// locale when they differ, such as verbs [%s] instead of [%d %s] of default locale en, empty when
+// they are the same or the default locale has no text. The order of the verbs is not compared,
+// translations may reorder args by explicit indexes like %[2]s.
+func (g *Generator) verbsMismatch(value Value, locale string) string {
+	if locale == g.defaultLocale || !g.parser.HasLocaleValue(value.key, g.defaultLocale) && value.comment == "" {
+		return ""
+	}
+	got, want := verbs(g.text(value, locale)), verbs(g.text(value, g.defaultLocale))
+	if got == want {
+		return ""
+	}
+	return fmt.Sprintf("fmt verbs [%s] instead of [%s] of default locale %s", got, want, g.defaultLocale)
+}
+
+// verbs returns the sorted fmt verbs of text joined by space, such as %d %s for "%s has %5d items",
+// flags, width, precision and argument indexes are left out, %% is not a verb
+func verbs(text string) string {
+	var res []string
+	for i := 0; i < len(text); i++ {
+		if text[i] != '%' {
+			continue
+		}
+		i++
+		for i < len(text) && strings.IndexByte("+-# 0123456789.*[]", text[i]) >= 0 {
+			i++
+		}
+		if i < len(text) && text[i] != '%' {
+			verb, size := utf8.DecodeRuneInString(text[i:])
+			res = append(res, "%"+string(verb))
+			i += size - 1
+		}
+	}
+	sort.Strings(res)
+	return strings.Join(res, " ")
+}
+
+// sortFindings sorts findings by package, type, locale, key, constant, kind and then position
+func sortFindings(items []Finding) []Finding {
+	order := make(map[Kind]int, len(kinds))
+	for n, item := range kinds {
+		order[item.kind] = n
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		switch {
+		case a.Package != b.Package:
+			return a.Package < b.Package
+		case a.Type != b.Type:
+			return a.Type < b.Type
+		case a.Locale != b.Locale:
+			return a.Locale < b.Locale
+		case a.Key != b.Key:
+			return a.Key < b.Key
+		case a.Const != b.Const:
+			return a.Const < b.Const
+		case a.Kind != b.Kind:
+			return order[a.Kind] < order[b.Kind]
+		case a.Pos.Filename != b.Pos.Filename:
+			return a.Pos.Filename < b.Pos.Filename
+		}
+		return a.Pos.Line < b.Pos.Line
+	})
+	return items
+}
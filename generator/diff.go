@@ -0,0 +1,170 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext lines of context around the changes of a hunk
+const diffContext = 3
+
+// edit one line of an edit script, op is ' ' kept, '-' deleted from old or '+' inserted from new
+type edit struct {
+	op   byte
+	line string
+}
+
+// Diff returns the unified diff of old and new contents of the file name with 3 lines of context,
+// as printed by git diff, empty when they are the same. A relative name is prefixed by a/ and b/.
+func Diff(name string, old, new []byte) []byte {
+	oldName, newName := name, name
+	if !strings.HasPrefix(name, "/") {
+		oldName, newName = "a/"+name, "b/"+name
+	}
+	edits := diffLines(splitLines(string(old)), splitLines(string(new)))
+
+	var b strings.Builder
+	oldLine, newLine := 1, 1 // line numbers of edits[i]
+	for i := 0; i < len(edits); {
+		if edits[i].op == ' ' {
+			oldLine, newLine, i = oldLine+1, newLine+1, i+1
+			continue
+		}
+
+		// a hunk starts with the context before the change, and ends once more than
+		// twice the context lines are kept after the last change
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end, kept := i, 0
+		for j := i; j < len(edits) && kept <= 2*diffContext; j++ {
+			if edits[j].op == ' ' {
+				kept++
+				continue
+			}
+			end, kept = j+1, 0
+		}
+		if end += diffContext; end > len(edits) {
+			end = len(edits)
+		}
+
+		oldStart, newStart := oldLine-(i-start), newLine-(i-start)
+		oldCount, newCount := 0, 0
+		for _, item := range edits[start:end] {
+			if item.op != '+' {
+				oldCount++
+			}
+			if item.op != '-' {
+				newCount++
+			}
+		}
+		if b.Len() == 0 {
+			fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+		}
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(oldStart, oldCount), hunkRange(newStart, newCount))
+		for _, item := range edits[start:end] {
+			b.WriteByte(item.op)
+			b.WriteString(item.line)
+			if !strings.HasSuffix(item.line, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		for _, item := range edits[i:end] {
+			if item.op != '+' {
+				oldLine++
+			}
+			if item.op != '-' {
+				newLine++
+			}
+		}
+		i = end
+	}
+	return []byte(b.String())
+}
+
+// hunkRange formats start,count of a hunk, an empty range starts at the line before it
+func hunkRange(start, count int) string {
+	if count == 0 {
+		start--
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+// splitLines splits s after each newline, the last line may have no newline
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the shortest edit script from a to b by the Myers algorithm
+func diffLines(a, b []string) []edit {
+	n, m := len(a), len(b)
+	max := n + m
+	v := make([]int, 2*max+2) // furthest x of diagonal k at v[max+k]
+	var trace [][]int         // v of diagonals -d..d before step d
+	for d := 0; d <= max; d++ {
+		trace = append(trace, append([]int(nil), v[max-d:max+d+1]...))
+		found := false
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && v[max+k-1] < v[max+k+1] {
+				x = v[max+k+1] // down, insert b[y]
+			} else {
+				x = v[max+k-1] + 1 // right, delete a[x]
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x, y = x+1, y+1
+			}
+			v[max+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+
+	// walk back from the end, the script is built in reverse
+	var edits []edit
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		at := func(k int) int { return trace[d][k+d] }
+		k := x - y
+		prevK, prevX := 0, 0 // the start at d 0
+		if d > 0 {
+			prevK = k - 1
+			if k == -d || k != d && at(k-1) < at(k+1) {
+				prevK = k + 1
+			}
+			prevX = at(prevK)
+		}
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x, y = x-1, y-1
+			edits = append(edits, edit{' ', a[x]})
+		}
+		if d > 0 {
+			if x == prevX {
+				edits = append(edits, edit{'+', b[prevY]})
+			} else {
+				edits = append(edits, edit{'-', a[prevX]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits
+}
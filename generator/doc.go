@@ -0,0 +1,269 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+)
+
+// format of the catalog documentation rendered by Doc
+const (
+	DocMarkdown = "markdown" // Markdown with a table per type and TOML table
+	DocHTML     = "html"     // standalone HTML page with the same tables
+)
+
+// catalogDoc one type of the catalog documentation
+type catalogDoc struct {
+	Package string         // import path of the package
+	Type    string         // type name
+	Anchor  string         // id of the section, unique across packages such as example-com-user-code
+	Ranges  []string       // value ranges of the constants like 1-5, empty for string types
+	Locales []string       // default locale first, then the others naturally sorted
+	Groups  []catalogGroup // constants by TOML table, top-level keys first
+}
+
+// catalogGroup constants of one type whose keys are under the same TOML table
+type catalogGroup struct {
+	Table string       // TOML table, empty for top-level keys
+	Rows  []catalogRow // constants in the order of their values
+}
+
+// catalogRow one constant of the catalog documentation
+type catalogRow struct {
+	Value string   // value of the constant
+	Name  string   // constant name
+	Doc   string   // doc or line comment of the constant
+	Texts []string // translations in the order of catalogDoc.Locales, empty when missing
+}
+
+// Doc returns the documentation of the constants of every type of pkgs, such as a catalog of error
+// codes for a developer portal, as Markdown by DocMarkdown or a standalone HTML page by DocHTML.
+// Each type lists its value ranges as generated, then its constants with value, name, doc comment
+// and the text of each locale, grouped by the TOML table of their keys in the default locale.
+func Doc(pkgs []*Package, format, title string) ([]byte, error) {
+	if format == "" {
+		format = DocMarkdown
+	}
+	if format != DocMarkdown && format != DocHTML {
+		return nil, fmt.Errorf("-format option only supports `%s` or `%s`, got `%s`", DocMarkdown, DocHTML, format)
+	}
+	var docs []catalogDoc
+	var types []string // type names of all packages, each once
+	seen := make(map[string]bool)
+	for _, p := range pkgs {
+		for _, typeName := range p.Types {
+			docs = append(docs, p.catalogDoc(typeName))
+			if !seen[typeName] {
+				seen[typeName] = true
+				types = append(types, typeName)
+			}
+		}
+	}
+	if title == "" {
+		title = "Catalog of " + strings.Join(types, ", ")
+	}
+	if format == DocHTML {
+		return docHTML(title, docs), nil
+	}
+	return docMarkdown(title, docs), nil
+}
+
+// catalogDoc returns the catalog documentation of type typeName of p
+func (p *Package) catalogDoc(typeName string) catalogDoc {
+	g := p.g
+	doc := catalogDoc{Package: p.Path, Type: typeName, Anchor: anchor(p.Path + "." + typeName), Locales: []string{g.defaultLocale}}
+	for _, locale := range g.parser.locales {
+		if locale != g.defaultLocale {
+			doc.Locales = append(doc.Locales, locale)
+		}
+	}
+	if g.basicType[typeName] != "string" {
+		for _, run := range splitIntoRuns(append([]Value(nil), g.values[typeName]...)) {
+			if len(run) == 1 {
+				doc.Ranges = append(doc.Ranges, run[0].str)
+				continue
+			}
+			doc.Ranges = append(doc.Ranges, run[0].str+"-"+run[len(run)-1].str)
+		}
+	}
+
+	// every constant, equal values included, translated as the lexically first name of them
+	values := append([]Value(nil), g.values[typeName]...)
+	sort.Stable(byValue(values))
+	groups := make(map[string]*catalogGroup)
+	var tables []string
+	var first Value
+	for i, value := range values {
+		if i == 0 || value.value != first.value || value.strVal != first.strVal {
+			first = value
+		}
+		table := g.table(first.key, doc.Locales)
+		if groups[table] == nil {
+			groups[table] = &catalogGroup{Table: table}
+			tables = append(tables, table)
+		}
+		row := catalogRow{Value: value.str, Name: value.originalName, Doc: value.doc}
+		for _, locale := range doc.Locales {
+			text := ""
+			if g.parser.HasLocaleValue(first.key, locale) || first.comment != "" && locale == g.defaultLocale {
+				text = g.text(first, locale)
+			}
+			row.Texts = append(row.Texts, text)
+		}
+		groups[table].Rows = append(groups[table].Rows, row)
+	}
+	sort.Strings(tables) // top-level keys first
+	for _, table := range tables {
+		doc.Groups = append(doc.Groups, *groups[table])
+	}
+	return doc
+}
+
+// anchor returns s as the id of an HTML element or a Markdown link target, lower case letters and
+// digits with any other run of characters as -, such as example-com-user-code of example.com/user.Code
+func anchor(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	return b.String()
+}
+
+// table returns the TOML table of key in the first of locales defining it, empty for top-level keys
+func (g *Generator) table(key string, locales []string) string {
+	for _, locale := range locales {
+		if g.parser.HasLocaleValue(key, locale) {
+			return g.parser.tables[locale][key]
+		}
+	}
+	return ""
+}
+
+// docMarkdown renders docs as Markdown, a section per type with a table per TOML table
+func docMarkdown(title string, docs []catalogDoc) []byte {
+	b := new(bytes.Buffer)
+	_, _ = fmt.Fprintf(b, "# %s\n", markdownCell(title))
+	if len(docs) > 1 {
+		b.WriteString("\n")
+		for _, doc := range docs {
+			_, _ = fmt.Fprintf(b, "- [%s](#%s) `%s`\n", doc.Type, doc.Anchor, doc.Package)
+		}
+	}
+	for _, doc := range docs {
+		_, _ = fmt.Fprintf(b, "\n<a id=\"%s\"></a>\n\n## %s\n\n", doc.Anchor, doc.Type)
+		_, _ = fmt.Fprintf(b, "Package `%s`", doc.Package)
+		if len(doc.Ranges) > 0 {
+			_, _ = fmt.Fprintf(b, ", values %s", strings.Join(doc.Ranges, ", "))
+		}
+		b.WriteString("\n")
+		for _, group := range doc.Groups {
+			if len(doc.Groups) > 1 {
+				_, _ = fmt.Fprintf(b, "\n### %s\n", groupTitle(group.Table))
+			}
+			_, _ = fmt.Fprintf(b, "\n| Value | Name | Description | %s |\n", strings.Join(doc.Locales, " | "))
+			_, _ = fmt.Fprintf(b, "| ---: | --- | --- |%s\n", strings.Repeat(" --- |", len(doc.Locales)))
+			for _, row := range group.Rows {
+				cells := []string{markdownCell(row.Value), "`" + row.Name + "`", markdownCell(row.Doc)}
+				for _, text := range row.Texts {
+					cells = append(cells, markdownCell(text))
+				}
+				_, _ = fmt.Fprintf(b, "| %s |\n", strings.Join(cells, " | "))
+			}
+		}
+	}
+	return b.Bytes()
+}
+
+// markdownCell escapes s for a cell of a Markdown table, newlines become <br>
+func markdownCell(s string) string {
+	r := strings.NewReplacer("\\", "\\\\", "|", "\\|", "`", "\\`", "*", "\\*", "_", "\\_", "<", "&lt;", ">", "&gt;",
+		"\r\n", "<br>", "\n", "<br>")
+	return r.Replace(s)
+}
+
+// docHTML renders docs as a standalone HTML page, a section per type with a table per TOML table
+func docHTML(title string, docs []catalogDoc) []byte {
+	b := new(bytes.Buffer)
+	_, _ = fmt.Fprintf(b, htmlHead, html.EscapeString(title))
+	if len(docs) > 1 {
+		b.WriteString("<ul>\n")
+		for _, doc := range docs {
+			_, _ = fmt.Fprintf(b, "<li><a href=\"#%s\">%s</a> <code>%s</code></li>\n", doc.Anchor, doc.Type, html.EscapeString(doc.Package))
+		}
+		b.WriteString("</ul>\n")
+	}
+	for _, doc := range docs {
+		_, _ = fmt.Fprintf(b, "<h2 id=\"%s\">%s</h2>\n", doc.Anchor, doc.Type)
+		_, _ = fmt.Fprintf(b, "<p>Package <code>%s</code>", html.EscapeString(doc.Package))
+		if len(doc.Ranges) > 0 {
+			_, _ = fmt.Fprintf(b, ", values %s", html.EscapeString(strings.Join(doc.Ranges, ", ")))
+		}
+		b.WriteString("</p>\n")
+		for _, group := range doc.Groups {
+			if len(doc.Groups) > 1 {
+				_, _ = fmt.Fprintf(b, "<h3>%s</h3>\n", html.EscapeString(groupTitle(group.Table)))
+			}
+			b.WriteString("<table>\n<thead><tr><th>Value</th><th>Name</th><th>Description</th>")
+			for _, locale := range doc.Locales {
+				_, _ = fmt.Fprintf(b, "<th>%s</th>", html.EscapeString(locale))
+			}
+			b.WriteString("</tr></thead>\n<tbody>\n")
+			for _, row := range group.Rows {
+				_, _ = fmt.Fprintf(b, "<tr><td class=\"value\">%s</td><td><code>%s</code></td><td>%s</td>",
+					htmlCell(row.Value), row.Name, htmlCell(row.Doc))
+				for _, text := range row.Texts {
+					_, _ = fmt.Fprintf(b, "<td>%s</td>", htmlCell(text))
+				}
+				b.WriteString("</tr>\n")
+			}
+			b.WriteString("</tbody>\n</table>\n")
+		}
+	}
+	b.WriteString("</body>\n</html>\n")
+	return b.Bytes()
+}
+
+// htmlCell escapes s for a cell of an HTML table, newlines become <br>
+func htmlCell(s string) string {
+	return strings.ReplaceAll(html.EscapeString(s), "\n", "<br>")
+}
+
+// groupTitle returns the heading of the constants under TOML table
+func groupTitle(table string) string {
+	if table == "" {
+		return "Top-level keys"
+	}
+	return "Table [" + table + "]"
+}
+
+// Argument to format is the escaped title.
+const htmlHead = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%[1]s</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+td.value { text-align: right; font-family: monospace; }
+</style>
+</head>
+<body>
+<h1>%[1]s</h1>
+`
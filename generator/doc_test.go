@@ -0,0 +1,74 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAnchor(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"Code", "code"},
+		{"example.com/user.Code", "example-com-user-code"},
+		{"github.com/jjonline/i18n-stringer/test/test_use_dir.Code", "github-com-jjonline-i18n-stringer-test-test-use-dir-code"},
+		{"_x/..ErrCode_", "x-errcode"},
+	}
+	for _, tc := range tests {
+		if got := anchor(tc.s); got != tc.want {
+			t.Errorf("anchor(%q) = %q, want %q", tc.s, got, tc.want)
+		}
+	}
+}
+
+// TestDocPackages renders types of the same name in two packages, each type must have its own
+// anchor linked from the table of contents, the title must name the types of both packages
+func TestDocPackages(t *testing.T) {
+	dir := loadFixture(t, "test_use_dir", Config{Types: []string{"Code", "Test", "Single"}})
+	fragments := loadFixture(t, "test_fragments", Config{Types: []string{"Code"}, DefaultLocale: "en", Fragments: "10000-20000"})
+	dir.Path, fragments.Path = "example.com/dir", "example.com/fragments"
+	pkgs := []*Package{dir, fragments}
+
+	tests := []struct {
+		format string
+		title  string
+		link   *regexp.Regexp
+		id     *regexp.Regexp
+	}{
+		{DocMarkdown, "# Catalog of Code, Test, Single\n", regexp.MustCompile(`\]\(#([a-z0-9-]+)\)`), regexp.MustCompile(`<a id="([a-z0-9-]+)"></a>`)},
+		{DocHTML, "<h1>Catalog of Code, Test, Single</h1>\n", regexp.MustCompile(`<a href="#([a-z0-9-]+)">`), regexp.MustCompile(`<h2 id="([a-z0-9-]+)">`)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			src, err := Doc(pkgs, tc.format, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(src), tc.title) {
+				t.Errorf("no title %q", tc.title)
+			}
+			var links, ids []string
+			for _, m := range tc.link.FindAllStringSubmatch(string(src), -1) {
+				links = append(links, m[1])
+			}
+			seen := make(map[string]bool)
+			for _, m := range tc.id.FindAllStringSubmatch(string(src), -1) {
+				if seen[m[1]] {
+					t.Errorf("id %s is not unique", m[1])
+				}
+				seen[m[1]] = true
+				ids = append(ids, m[1])
+			}
+			want := []string{"example-com-dir-code", "example-com-dir-test", "example-com-dir-single", "example-com-fragments-code"}
+			if strings.Join(links, " ") != strings.Join(want, " ") || strings.Join(ids, " ") != strings.Join(want, " ") {
+				t.Errorf("links %v and ids %v, want %v", links, ids, want)
+			}
+		})
+	}
+}
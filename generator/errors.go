@@ -0,0 +1,72 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"go/token"
+)
+
+// Error one problem found at a position of a Go source or TOML file
+type Error struct {
+	Pos token.Position // file:line:column of absolute file path, only Filename for a whole file, zero when not about a file
+	Msg string
+}
+
+// Error implement error, formatted as file:line:column: message
+func (e *Error) Error() string {
+	if e.Pos.Filename != "" || e.Pos.IsValid() {
+		return e.Pos.String() + ": " + e.Msg
+	}
+	return e.Msg
+}
+
+// ErrorList all problems found by Load in the order found, ended by "too many errors"
+// when the limit of Config.MaxErrors is reached
+type ErrorList []*Error
+
+// Error implement error, the first problem and the number of the others
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// errorCollector collects the problems of a Load up to a limit
+type errorCollector struct {
+	list ErrorList
+	max  int // no limit when 0
+}
+
+// add records a problem at pos, the same problem as the last one such as a directive shared
+// by several constants is recorded once. Once the limit is reached "too many errors" is recorded
+// and any later problem is dropped.
+func (c *errorCollector) add(pos token.Position, format string, args ...interface{}) {
+	e := &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+	if c.full() || len(c.list) > 0 && *c.list[len(c.list)-1] == *e {
+		return
+	}
+	if c.max > 0 && len(c.list) == c.max {
+		e = &Error{Msg: "too many errors"}
+	}
+	c.list = append(c.list, e)
+}
+
+// full reports whether the limit is exceeded, so that parsing stops early
+func (c *errorCollector) full() bool {
+	return c.max > 0 && len(c.list) > c.max
+}
+
+// err returns the collected problems as ErrorList, nil when there is none
+func (c *errorCollector) err() error {
+	if len(c.list) == 0 {
+		return nil
+	}
+	return c.list
+}
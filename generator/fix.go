@@ -0,0 +1,139 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// fill of the key-value pairs added by Fix
+const (
+	FillEmpty   = "empty"   // empty string
+	FillDefault = "default" // the text of the default locale, empty for the default locale itself
+)
+
+// todoComment marks each key-value pair added by Fix for translators
+const todoComment = "# TODO(translate)"
+
+// Fix returns the TOML files of p with the missing key-value pairs found by Check added, one file per
+// locale that misses any key. Keys of a single file locale like i18n/en.toml are added to it, keys of
+// a locale subdirectory to its file named by Config.FixFile, created when not exist, or its first file.
+// Each key is filled by Config.FixFill after a # TODO(translate) comment with the note of the constant,
+// existing lines are kept as they are, the keys are added before the first TOML table, if any.
+func Fix(p *Package) ([]File, error) {
+	g := p.g
+	blocks := make(map[string][]string) // lines to add by file
+	added := make(map[string]bool)      // file and key added, a key shared by types is added once
+	for _, item := range Check(p) {
+		if item.Kind != KindMissing && item.Kind != KindMissingFragment || item.Allowed {
+			continue
+		}
+		file := g.fixTarget(item.Locale, p.cfg.FixFile)
+		if added[file+"\x00"+item.Key] {
+			continue
+		}
+		added[file+"\x00"+item.Key] = true
+
+		text := ""
+		if p.cfg.FixFill == FillDefault && item.Locale != g.defaultLocale {
+			for _, value := range g.values[item.Type] {
+				if value.key == item.Key {
+					text = g.text(value, g.defaultLocale)
+					break
+				}
+			}
+		}
+		comment := todoComment
+		if item.Note != "" {
+			comment += ": " + item.Note
+		}
+		blocks[file] = append(blocks[file], comment, item.Key+"="+quoteTOML(text))
+	}
+
+	files := make([]string, 0, len(blocks))
+	for file := range blocks {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	res := make([]File, 0, len(files))
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		res = append(res, File{Name: file, Source: insertLines(src, blocks[file])})
+	}
+	return res, nil
+}
+
+// fixTarget returns the TOML file of locale which Fix adds keys to
+func (g *Generator) fixTarget(locale, name string) string {
+	files := append([]string{}, g.parser.files[locale]...)
+	sort.Strings(files)
+	for _, file := range files {
+		if filepath.Clean(filepath.Dir(file)) == filepath.Clean(g.parser.path) {
+			return file // single file locale
+		}
+	}
+	if name != "" {
+		return filepath.Join(g.parser.path, locale, name)
+	}
+	return files[0]
+}
+
+// insertLines returns src with lines added before the first TOML table and the comments right
+// above it, or at the end, separated by blank lines. Line endings of src are kept.
+func insertLines(src []byte, lines []string) []byte {
+	text := string(src)
+	newline := "\n"
+	if strings.Contains(text, "\r\n") {
+		newline = "\r\n"
+	}
+
+	// offset of the first table, moved up over the comment lines right above it
+	offset := len(text)
+	existing := strings.SplitAfter(text, "\n")
+	start := 0
+	for i, line := range existing {
+		trimmed := strings.Trim(line, " \t\r\n")
+		if len(trimmed) > 0 && trimmed[0] == '[' && trimmed[len(trimmed)-1] == ']' {
+			j := i
+			for j > 0 {
+				above := strings.Trim(existing[j-1], " \t\r\n")
+				if len(above) == 0 || above[0] != '#' {
+					break
+				}
+				j--
+			}
+			offset = start
+			for _, item := range existing[j:i] {
+				offset -= len(item)
+			}
+			break
+		}
+		start += len(line)
+	}
+	head, tail := text[:offset], text[offset:]
+
+	var b strings.Builder
+	b.WriteString(head)
+	if head != "" && !strings.HasSuffix(head, "\n") {
+		b.WriteString(newline)
+	}
+	body := strings.TrimSuffix(strings.TrimSuffix(head, "\n"), "\r")
+	if last := body[strings.LastIndex(body, "\n")+1:]; strings.Trim(last, " \t\r\xef\xbb\xbf") != "" {
+		b.WriteString(newline) // blank line after the last key-value pair or comment
+	}
+	for _, line := range lines {
+		b.WriteString(line + newline)
+	}
+	if tail != "" {
+		b.WriteString(newline + tail)
+	}
+	return []byte(b.String())
+}
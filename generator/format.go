@@ -0,0 +1,196 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// order of keys in canonical TOML
+const (
+	OrderValue = "value" // by the values of the constants, as the generated tables
+	OrderKey   = "key"   // alphabetically
+)
+
+// tomlEntry one key-value pair with the comments right above it
+type tomlEntry struct {
+	key   string
+	lines []string
+}
+
+// tomlSection the key-value pairs before any table, or of one table
+type tomlSection struct {
+	header  []string // the table line with the comments above it, empty before any table
+	entries []tomlEntry
+	trailer []string // comments after the last key-value pair of the last section
+}
+
+// FormatTOML returns the canonical form of the TOML catalog src of the file name:
+//  - key-value pairs of each table ordered by rank, keys without rank after them alphabetically,
+//    alphabetically when rank is nil, duplicate keys keep their order
+//  - values quoted and escaped the way UnquoteTOML reads them, written as Key="value"
+//  - comments above a key-value pair move with it, comments at the top of the file separated by
+//    a blank line stay on top and comments above a table stay with it
+//  - no blank line between key-value pairs, one blank line before each table
+//
+// Problems of lines are returned as ErrorList with their positions.
+func FormatTOML(name string, src []byte, rank map[string]int) ([]byte, error) {
+	errs := &errorCollector{}
+	var head, pending []string
+	sections := []*tomlSection{{}}
+	lines := strings.Split(string(src), "\n")
+	for i := range lines {
+		line := strings.Trim(lines[i], " \t\r")
+		current := sections[len(sections)-1]
+		switch {
+		case line == "":
+			// comments before anything else separated by a blank line are the head of the file
+			if len(sections) == 1 && len(current.entries) == 0 {
+				head, pending = append(head, pending...), nil
+			}
+		case line[0] == '#':
+			pending = append(pending, line)
+		case line[0] == '[' && line[len(line)-1] == ']':
+			table := "[" + strings.Trim(line[1:len(line)-1], " \t") + "]"
+			sections = append(sections, &tomlSection{header: append(pending, table)})
+			pending = nil
+		case strings.Contains(line, "="):
+			key, value, ok := formatLine(name, i, lines[i], errs)
+			if ok {
+				current.entries = append(current.entries, tomlEntry{key: key, lines: append(pending, key+"="+value)})
+				pending = nil
+			}
+		default:
+			pending = append(pending, line) // not a key-value pair, kept as it is
+		}
+	}
+	if err := errs.err(); err != nil {
+		return nil, err
+	}
+	sections[len(sections)-1].trailer = pending
+
+	var b strings.Builder
+	write := func(lines []string) {
+		for _, line := range lines {
+			b.WriteString(line + "\n")
+		}
+	}
+	write(head)
+	for _, section := range sections {
+		entries := section.entries
+		sort.SliceStable(entries, func(i, j int) bool {
+			ri, iok := rank[entries[i].key]
+			rj, jok := rank[entries[j].key]
+			if iok != jok || iok && ri != rj {
+				return iok && (!jok || ri < rj)
+			}
+			return !iok && entries[i].key < entries[j].key
+		})
+		if len(section.header) > 0 && b.Len() > 0 || len(head) > 0 && len(section.header) == 0 && len(entries) > 0 {
+			b.WriteString("\n")
+		}
+		write(section.header)
+		for _, entry := range entries {
+			write(entry.lines)
+		}
+		write(section.trailer)
+	}
+	return []byte(b.String()), nil
+}
+
+// formatLine returns the key and the canonical value of the key-value pair of line i, with the
+// comment after it, problems are added to errs
+func formatLine(name string, i int, line string, errs *errorCollector) (string, string, bool) {
+	trimmed := strings.Trim(line, " \t\r")
+	idx := strings.Index(trimmed, "=")
+	key := strings.Trim(trimmed[:idx], " \t")
+	value := strings.Trim(trimmed[idx+1:], " \t")
+	if len(value) == 0 {
+		return key, `""`, true
+	}
+	if value[0] != '"' {
+		errs.add(linePosition(name, i, line, true), "value of key `%s` must be using double quotes", key)
+		return "", "", false
+	}
+	text, end, ok := parseString(value)
+	if !ok {
+		errs.add(linePosition(name, i, line, true), "value of key `%s` parse faild, backslash(\\) may be used incorrectly", key)
+		return "", "", false
+	}
+	res := quoteTOML(text)
+	if end > 0 {
+		if rest := strings.Trim(value[end:], " \t"); rest != "" {
+			res += " " + rest // comment after the value
+		}
+	}
+	return key, res, true
+}
+
+// Format returns the TOML files of pkgs in canonical form by FormatTOML, only the files changed.
+// With OrderValue keys are ordered by the values of the constants of the types of all the packages
+// sharing the TOML directory, in the order of pkgs and Config.Types, otherwise alphabetically.
+func Format(pkgs []*Package, order string) ([]File, error) {
+	if order != OrderValue && order != OrderKey {
+		return nil, fmt.Errorf("-order option only supports `%s` or `%s`, got `%s`", OrderValue, OrderKey, order)
+	}
+	parsers := make(map[string]*parser)      // TOML directory to its parsed files
+	ranks := make(map[string]map[string]int) // TOML directory to the rank of keys
+	for _, p := range pkgs {
+		dir, err := filepath.Abs(p.g.parser.path)
+		if err != nil {
+			return nil, err
+		}
+		parsers[dir] = p.g.parser
+		if order == OrderKey {
+			continue
+		}
+		if ranks[dir] == nil {
+			ranks[dir] = make(map[string]int)
+		}
+		rank := ranks[dir]
+		for _, typeName := range p.Types {
+			values := make([]Value, len(p.g.values[typeName]))
+			copy(values, p.g.values[typeName])
+			sort.Stable(byValue(values))
+			for _, value := range values {
+				if _, ok := rank[value.key]; !ok {
+					rank[value.key] = len(rank)
+				}
+			}
+		}
+	}
+
+	dirs := make([]string, 0, len(parsers))
+	for dir := range parsers {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	var res []File
+	for _, dir := range dirs {
+		var files []string
+		for _, locale := range parsers[dir].locales {
+			files = append(files, parsers[dir].files[locale]...)
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			src, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			source, err := FormatTOML(file, src, ranks[dir])
+			if err != nil {
+				return nil, err
+			}
+			if string(source) != string(src) {
+				res = append(res, File{Name: file, Source: source})
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFormatTOML(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"ascii", "B = \"b\"\nA=\"a\"\n", "A=\"a\"\nB=\"b\"\n"},
+		{"cjk", "Ok=\"操作成功\"\n", "Ok=\"操作成功\"\n"},
+		{"cjk spaces", "Ok = \"操作成功\"  \n", "Ok=\"操作成功\"\n"},
+		{"cjk comment", "Ok = \"操作成功\"   # 成功\n", "Ok=\"操作成功\" # 成功\n"},
+		{"cjk escapes", "Ok=\"「引号\\\"」和\\\\反斜杠\\n\"\n", "Ok=\"「引号\\\"」和\\\\反斜杠\\n\"\n"},
+		{"emoji", "Ok=\"👍 done\" # ✅\n", "Ok=\"👍 done\" # ✅\n"},
+		{"table", "[fragments]\nName=\"姓名\"\nAge=\"年龄\"\n", "[fragments]\nAge=\"年龄\"\nName=\"姓名\"\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FormatTOML("en.toml", []byte(tc.src), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("FormatTOML(%q) = %q, want %q", tc.src, got, tc.want)
+			}
+			again, err := FormatTOML("en.toml", got, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(again) != string(got) {
+				t.Errorf("FormatTOML is not idempotent: %q then %q", got, again)
+			}
+		})
+	}
+}
+
+// TestFormatTOMLCatalogs formats every TOML file of the repository, the key-value pairs read
+// back must be the same and formatting again must not change anything
+func TestFormatTOMLCatalogs(t *testing.T) {
+	var names []string
+	for _, root := range []string{"../example", "../test"} {
+		err := filepath.WalkDir(root, func(name string, d os.DirEntry, err error) error {
+			if err == nil && !d.IsDir() && strings.HasSuffix(name, ".toml") {
+				names = append(names, name)
+			}
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range names {
+		t.Run(name, func(t *testing.T) {
+			src, err := os.ReadFile(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := FormatTOML(name, src, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			again, err := FormatTOML(name, got, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(again) != string(got) {
+				t.Errorf("FormatTOML is not idempotent:\n%s", Diff(name, got, again))
+			}
+			formatted := filepath.Join(t.TempDir(), "formatted.toml")
+			if err = os.WriteFile(formatted, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+			if want, values := readTOML(t, name), readTOML(t, formatted); !reflect.DeepEqual(values, want) {
+				t.Errorf("key-value pairs changed by FormatTOML:\n%s", Diff(name, src, got))
+			}
+		})
+	}
+}
+
+// readTOML returns the key-value pairs of the TOML file name as read by the parser
+func readTOML(t *testing.T, name string) map[string]string {
+	t.Helper()
+	p := newParser(filepath.Dir(name), func(string, ...interface{}) {}, &errorCollector{})
+	p.readOneToml(name, "en")
+	if err := p.errs.err(); err != nil {
+		t.Fatal(err)
+	}
+	return p.localesMap["en"]
+}
@@ -0,0 +1,877 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+// Package generator generates the i18n methods of the constants of integer or string types
+// translated by TOML files, it is the library behind the i18n-stringer command, which is a thin
+// wrapper of it.
+//
+// Load loads the packages declaring the types with their TOML files, then Generate returns the
+// formatted sources of the files of each package, or Check reports missing, useless or suspicious key-value
+// pairs in the TOML files, which Fix adds to them. Errors are returned instead of exiting.
+//
+//	pkgs, err := generator.Load(generator.Config{Types: []string{"ErrCode"}, Patterns: []string{"./..."}})
+//	if err != nil {
+//		return err
+//	}
+//	for _, pkg := range pkgs {
+//		files, err := generator.Generate(pkg)
+//		if err != nil {
+//			return err
+//		}
+//		for _, file := range files {
+//			if err := os.WriteFile(file.Name, file.Source, 0644); err != nil {
+//				return err
+//			}
+//		}
+//	}
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"golang.org/x/tools/go/packages"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// generate mode
+const (
+	ModeConst = "const" // translations compiled into const name strings and index tables
+	ModeEmbed = "embed" // translations copied into a JSON asset loaded lazily by go:embed
+)
+
+// Config options of one run, fields are the flags of the i18n-stringer command
+type Config struct {
+	Types         []string // type names; must be set
+	Patterns      []string // package patterns like ./..., directories or files of a single package; default "."
+	Tags          []string // build tags to apply
+	TomlPath      string   // TOML i18n file path; default the i18n directory of each package
+	DefaultLocale string   // default locale name; default naturally sorted first
+	CtxKey        string   // key used by context.Value for get locale; default i18nLocale
+	Output        string   // output file name of a single package; default <package dir>/<type>_i18n_string.go
+	Mode          string   // generate mode ModeConst or ModeEmbed; default ModeConst
+	SplitLocales  bool     // generate one file per locale guarded by build tag i18n_<locale>
+	Fragments     string   // comma-separated list of value ranges lo-hi of placeholder fragment constants
+	Bitmask       bool     // translate combined values of bit flag types as the joined translations of each bit
+	TrimPrefix    string   // trim the prefix from the constant names to get TOML keys
+	LineComment   bool     // use line comment text as default locale text when TOML has no value
+	CommandLine   string   // flags and args written into the header of generated files; default -type T
+	MaxErrors     int      // stop collecting problems of Load after this many; default no limit
+	Allowlist     string   // file of known gaps marked Allowed by Check, see readAllowlist
+	FixFile       string   // file name in locale subdirectories which Fix adds keys to; default the first file
+	FixFill       string   // fill of keys added by Fix, FillEmpty or FillDefault; default FillEmpty
+	GenTest       bool     // also generate <output>_test.go checking the translations at test time
+	Pseudo        string   // pseudo locale such as qps-ploc generated from the default locale, see pseudoText
+	ShowKeys      bool     // also generate the debug mode showing the constant of each message, see buildShowKeys
+
+	// Severities of kinds of Finding reported by Check, see ParseSeverities for the defaults
+	Severities map[Kind]Severity
+
+	// Logf receives notices such as ignored files and duplicate TOML keys, discarded when nil
+	Logf func(format string, args ...interface{})
+}
+
+// Package one loaded package declaring any of the types of Config
+type Package struct {
+	Name  string   // package name
+	Path  string   // package import path
+	Dir   string   // package directory, the default output directory
+	Types []string // types of Config declared in the package, in the order of Config.Types
+
+	cfg   Config      // options of the run
+	g     *Generator  // parsed constants and TOML files
+	allow []allowRule // known gaps of Config.Allowlist
+}
+
+// File one generated file
+type File struct {
+	Name   string // file path, in the package directory unless Config.Output is set
+	Source []byte // gofmt-ed source, or the JSON catalog asset of embed mode
+}
+
+// Load loads the packages matched by the patterns of cfg with a single packages.Load. Patterns like
+// ./... or several directories may match many packages, every package declaring any of the types is
+// returned, otherwise the patterns must name exactly one package, which must declare all the types.
+// The TOML files and constants of each package are parsed, all the problems found are returned
+// together as ErrorList with their positions.
+func Load(cfg Config) ([]*Package, error) {
+	return loadAll(cfg, true)
+}
+
+// loadAll loads the packages of cfg as Load, the TOML files are not parsed unless catalogs
+func loadAll(cfg Config, catalogs bool) ([]*Package, error) {
+	if len(cfg.Types) == 0 {
+		return nil, errors.New("no type set")
+	}
+	if cfg.Mode != "" && cfg.Mode != ModeConst && cfg.Mode != ModeEmbed {
+		return nil, fmt.Errorf("-mode option only supports `%s` or `%s`, got `%s`", ModeConst, ModeEmbed, cfg.Mode)
+	}
+	if cfg.SplitLocales && cfg.Mode == ModeEmbed {
+		return nil, fmt.Errorf("-splitlocales option can not be used with -mode %s", ModeEmbed)
+	}
+	ranges, err := parseRanges(cfg.Fragments)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.FixFill != "" && cfg.FixFill != FillEmpty && cfg.FixFill != FillDefault {
+		return nil, fmt.Errorf("-fixfill option only supports `%s` or `%s`, got `%s`", FillEmpty, FillDefault, cfg.FixFill)
+	}
+	if cfg.FixFile != "" && (filepath.Base(cfg.FixFile) != cfg.FixFile || !strings.HasSuffix(cfg.FixFile, ".toml")) {
+		return nil, fmt.Errorf("-fixfile option must be a TOML file name in locale subdirectories, got `%s`", cfg.FixFile)
+	}
+	for kind, severity := range cfg.Severities {
+		if err = validKind(kind); err != nil {
+			return nil, err
+		}
+		if err = validSeverity(kind, severity); err != nil {
+			return nil, err
+		}
+	}
+
+	// We accept either one directory or a list of files. Which do we have?
+	patterns := cfg.Patterns
+	if len(patterns) == 0 {
+		// Default: process whole package in current directory.
+		patterns = []string{"."}
+	}
+
+	// Patterns like ./... or several directories may match many packages, all of them are loaded at once.
+	multi := strings.Contains(strings.Join(patterns, " "), "...")
+	isDir := false
+	if !multi {
+		if isDir, err = isDirectory(patterns[0]); err != nil {
+			return nil, err
+		}
+		multi = isDir && len(patterns) > 1
+	}
+
+	var dir string
+	if multi {
+		// each package has its own directory
+	} else if isDir {
+		dir = patterns[0]
+	} else {
+		if len(cfg.Tags) != 0 {
+			return nil, errors.New("-tags option applies only to directories, not when files are specified")
+		}
+		dir = filepath.Dir(patterns[0])
+	}
+
+	pkgs, err := loadPackages(patterns, cfg.Tags)
+	if err != nil {
+		return nil, err
+	}
+	errs := &errorCollector{max: cfg.MaxErrors}
+	allow := readAllowlist(absPath(cfg.Allowlist), errs)
+	if !multi {
+		if len(pkgs) != 1 {
+			return nil, fmt.Errorf("error: %d packages found", len(pkgs))
+		}
+		p := load(cfg, pkgs[0], cfg.Types, dir, ranges, catalogs, errs)
+		p.allow = allow
+		if err = errs.err(); err != nil {
+			return nil, err
+		}
+		return []*Package{p}, nil
+	}
+
+	// One output file per package that declares any of the types,
+	// TOML files default to the i18n directory of each package.
+	if cfg.Output != "" {
+		return nil, errors.New("-output option applies only to a single package")
+	}
+	var res []*Package
+	for _, pkg := range pkgs {
+		items := declaredTypes(pkg, cfg.Types)
+		if len(items) == 0 {
+			continue
+		}
+		if errs.full() {
+			break
+		}
+		p := load(cfg, pkg, items, filepath.Dir(pkg.GoFiles[0]), ranges, catalogs, errs)
+		p.allow = allow
+		res = append(res, p)
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("none of the types %s is declared in %d packages", strings.Join(cfg.Types, ","), len(pkgs))
+	}
+	if err = errs.err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// load parses the TOML files unless not catalogs, and the constants of the types declared in one
+// package in dir, problems are added to errs, the constants are not parsed when the TOML files can
+// not be read.
+func load(cfg Config, pkg *packages.Package, typeItems []string, dir string, ranges []valueRange, catalogs bool, errs *errorCollector) *Package {
+	g := &Generator{
+		ctxKey:        ternary(cfg.CtxKey, "i18nLocale"),
+		defaultLocale: cfg.DefaultLocale, // default locale
+		mode:          ternary(cfg.Mode, ModeConst),
+		trimPrefix:    cfg.TrimPrefix,
+		lineComment:   cfg.LineComment,
+		bitmask:       cfg.Bitmask,
+		showKeys:      cfg.ShowKeys,
+		logf:          cfg.Logf,
+		errs:          errs,
+		values:        make(map[string][]Value), // init const value
+		basicType:     make(map[string]string),  // init basic TYPE value
+	}
+	if g.logf == nil {
+		g.logf = func(string, ...interface{}) {}
+	}
+
+	p := &Package{
+		Name:  pkg.Name,
+		Path:  pkg.PkgPath,
+		Dir:   dir,
+		Types: typeItems,
+		cfg:   cfg,
+		g:     g,
+	}
+
+	// parse toml locale config file
+	g.parser = newParser(absPath(ternary(cfg.TomlPath, filepath.Join(dir, "i18n"))), g.logf, errs)
+	if !catalogs {
+		// only the constants, such as bootstrapping the TOML files by Init
+	} else if !g.parser.parse() {
+		return p
+	} else if g.defaultLocale == "" {
+		// set default locale when command param do not set
+		g.defaultLocale = g.parser.locales[0] // default naturally sorted first
+	} else {
+		// check if specify locale is in TOML set
+		isIn := false
+		for _, locale := range g.parser.locales {
+			if g.defaultLocale == locale {
+				isIn = true
+				break
+			}
+		}
+		if !isIn {
+			errs.add(token.Position{Filename: g.parser.path}, "The locale `%s` by -defaultlocale is not found in the TOML", g.defaultLocale)
+		}
+	}
+
+	// parse package type && const info
+	g.addPackage(pkg)
+
+	// parse const value for eve Type
+	for _, typeName := range typeItems {
+		g.parseConstValues(typeName)
+	}
+
+	// bits only exist in integers
+	for _, typeName := range typeItems {
+		if g.bitmask && g.basicType[typeName] == "string" {
+			errs.add(g.typePos(typeName), "-bitmask option can not be used with string type %s", typeName)
+		}
+	}
+
+	// mark placeholder fragment constants by -fragments, //i18n:fragment or TOML table [fragments]
+	for _, typeName := range typeItems {
+		g.markFragments(typeName, ranges)
+	}
+
+	// the pseudo locale derived from the default locale texts, for UI testing
+	if catalogs && cfg.Pseudo != "" {
+		g.addPseudo(cfg.Pseudo, typeItems)
+	}
+	return p
+}
+
+// Generate returns the generated files of p, the output file first, followed by the split
+// locale files of Config.SplitLocales or the JSON catalog asset of embed mode, and the
+// _test.go file of Config.GenTest.
+func Generate(p *Package) ([]File, error) {
+	typeItems := p.Types
+
+	// Each run starts from the parsed constants with empty buffers.
+	g := &Generator{
+		pkg:           p.g.pkg,
+		parser:        p.g.parser,
+		values:        p.g.values,
+		basicType:     p.g.basicType,
+		catalog:       make(map[string]catalogType), // init embed catalog asset
+		ctxKey:        p.g.ctxKey,
+		defaultLocale: p.g.defaultLocale,
+		mode:          p.g.mode,
+		bitmask:       p.g.bitmask,
+		showKeys:      p.g.showKeys,
+		logf:          p.g.logf,
+	}
+
+	// The default locale always stays in the core output file, every other locale
+	// goes to its own file guarded by a build tag when split.
+	g.locales = g.parser.locales
+	g.transFunc = "_transIdx"
+	if p.cfg.SplitLocales {
+		g.locales = []string{g.defaultLocale}
+		g.transFunc = "_transIdx" + camelCase(g.defaultLocale)
+		g.splitFile = true
+		for _, locale := range g.parser.locales {
+			if locale == g.defaultLocale {
+				continue
+			}
+			g.localeFiles = append(g.localeFiles, &Generator{
+				pkg:           g.pkg,
+				parser:        g.parser,
+				basicType:     g.basicType,
+				locales:       []string{locale},
+				transFunc:     "_transIdx" + camelCase(locale),
+				splitFile:     true,
+				defaultLocale: g.defaultLocale,
+				logf:          g.logf,
+			})
+		}
+	}
+
+	// output file name
+	outputName := p.cfg.Output
+	if outputName == "" {
+		baseName := fmt.Sprintf("%s_i18n_string.go", typeItems[0])
+		outputName = filepath.Join(p.Dir, strings.ToLower(baseName))
+	}
+	commandLine := ternary(p.cfg.CommandLine, "-type "+strings.Join(p.cfg.Types, ","))
+
+	// Print the header and package clause.
+	g.Printf("// Code generated by \"i18n-stringer %s\"; DO NOT EDIT.\n", commandLine)
+	g.Printf("\n")
+	g.Printf("package %s", g.pkg.name)
+	g.Printf("\n")
+	g.Printf("import (\n")
+	g.Printf("\"context\"\n")
+	if g.mode == ModeEmbed {
+		g.Printf("_ \"embed\"\n")
+		g.Printf("\"encoding/json\"\n")
+	}
+	g.Printf("\"fmt\"\n")
+	g.Printf("\"io\"\n")
+	if g.showKeys {
+		g.Printf("\"os\"\n")
+	}
+	g.Printf("\"strconv\"\n")
+	if g.bitmask {
+		g.Printf("\"strings\"\n")
+	}
+	g.Printf("\"sync\"\n")
+	if g.showKeys {
+		g.Printf("\"sync/atomic\"\n")
+	}
+	g.Printf(")\n")
+
+	// Texts of several types generated in one run share a deduplicated string pool
+	// per output file, each split locale file has its own pool.
+	if len(typeItems) > 1 && g.mode == ModeConst && sharePool {
+		g.pool = newPool("_" + typeItems[0] + "_pool")
+		for _, lg := range g.localeFiles {
+			lg.pool = newPool("_" + typeItems[0] + "_" + camelCase(lg.locales[0]) + "_pool")
+		}
+		for _, gen := range append([]*Generator{g}, g.localeFiles...) {
+			for _, typeName := range typeItems {
+				gen.collectPool(g.values[typeName])
+			}
+		}
+	}
+
+	// Print the header of each split locale file.
+	for _, lg := range g.localeFiles {
+		tag := localeTag(lg.locales[0])
+		lg.Printf("// Code generated by \"i18n-stringer %s\"; DO NOT EDIT.\n", commandLine)
+		lg.Printf("\n")
+		lg.Printf("//go:build %s\n", tag)
+		lg.Printf("// +build %s\n", tag)
+		lg.Printf("\n")
+		lg.Printf("package %s", g.pkg.name)
+		lg.Printf("\n")
+		lg.Printf("import \"strconv\"\n")
+	}
+
+	// declare the embedded catalog asset shared by all types
+	assetName := strings.TrimSuffix(outputName, ".go") + ".json"
+	if g.mode == ModeEmbed {
+		g.assetOwner = typeItems[0]
+		g.Printf(embedAsset, g.assetOwner, filepath.Base(assetName))
+	}
+
+	// Run generate for each type.
+	for _, typeName := range typeItems {
+		g.generate(typeName)
+	}
+
+	// declare the shared string pool at last
+	if g.pool != nil {
+		for _, gen := range append([]*Generator{g}, g.localeFiles...) {
+			gen.declarePool()
+		}
+	}
+
+	// Format the output.
+	src, err := g.format(outputName)
+	if err != nil {
+		return nil, err
+	}
+	files := []File{{Name: outputName, Source: src}}
+
+	// Split locale files next to the output file.
+	for _, lg := range g.localeFiles {
+		localeName := fmt.Sprintf("%s_locale_%s.go", strings.TrimSuffix(outputName, ".go"), localeTag(lg.locales[0])[len("i18n_"):])
+		if src, err = lg.format(localeName); err != nil {
+			return nil, err
+		}
+		files = append(files, File{Name: localeName, Source: src})
+	}
+
+	// The catalog asset next to the output file.
+	if g.mode == ModeEmbed {
+		asset, err := g.catalogAsset()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, File{Name: assetName, Source: asset})
+	}
+
+	// The test of the translations next to the output file.
+	if p.cfg.GenTest {
+		testName := strings.TrimSuffix(outputName, ".go") + "_test.go"
+		if src, err = g.buildTest(typeItems, commandLine).format(testName); err != nil {
+			return nil, err
+		}
+		files = append(files, File{Name: testName, Source: src})
+	}
+	return files, nil
+}
+
+// collectPool adds texts of all locales of g for the values of one type into the pool,
+// so that the size of pool ids is known before any of them declared.
+func (g *Generator) collectPool(typeValues []Value) {
+	values := make([]Value, len(typeValues))
+	copy(values, typeValues) // splitIntoRuns sorts in place
+	runs := splitIntoRuns(values)
+	for _, locale := range g.locales {
+		for _, run := range runs {
+			for _, value := range run {
+				g.pool.add(g.text(value, locale))
+			}
+		}
+	}
+}
+
+// declarePool declares the shared string pool and its boundaries.
+func (g *Generator) declarePool() {
+	p := g.pool
+	g.Printf("\n")
+	g.Printf("// %s deduplicated texts shared by all types and locales in this file\n", p.name)
+	g.Printf("const %s = %q\n\n", p.name, strings.Join(p.texts, ""))
+	g.Printf("// %s_index boundaries of each text in %s\n", p.name, p.name)
+	g.Printf("var %s_index = [...]uint%d{%s}\n\n", p.name, usize(p.index[len(p.index)-1]), joinInts(p.index))
+	g.Printf("// %sText get text by id from %s\n", p.name, p.name)
+	g.Printf("func %sText(id int) string {\n", p.name)
+	g.Printf("\treturn %s[%s_index[id]:%s_index[id+1]]\n", p.name, p.name, p.name)
+	g.Printf("}\n")
+}
+
+// Pool deduplicated string table shared by all types and locales of one generated file
+type Pool struct {
+	name  string         // const name of the pool string
+	ids   map[string]int // text to id
+	texts []string       // texts in id order
+	index []int          // boundaries of texts in pool string, len(texts)+1
+}
+
+// newPool new instance for Pool
+func newPool(name string) *Pool {
+	return &Pool{
+		name:  name,
+		ids:   make(map[string]int),
+		index: []int{0},
+	}
+}
+
+// add text into pool when not exist, returns the text id
+func (p *Pool) add(text string) int {
+	if id, ok := p.ids[text]; ok {
+		return id
+	}
+	id := len(p.texts)
+	p.ids[text] = id
+	p.texts = append(p.texts, text)
+	p.index = append(p.index, p.index[id]+len(text))
+	return id
+}
+
+// joinInts join ints with comma
+func joinInts(items []int) string {
+	res := make([]string, len(items))
+	for i, item := range items {
+		res[i] = strconv.Itoa(item)
+	}
+	return strings.Join(res, ", ")
+}
+
+// ternary when empty get default
+func ternary(from, dVal string) string {
+	if from == "" {
+		return dVal
+	}
+	return from
+}
+
+// absPath returns path as absolute path, so that positions of Go and TOML files are alike,
+// empty path or path failing filepath.Abs as it is
+func absPath(path string) string {
+	if path == "" {
+		return path
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return path
+}
+
+// isDirectory reports whether the named file is a directory.
+func isDirectory(name string) (bool, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return false, err
+	}
+	return info.IsDir(), nil
+}
+
+// sharePool whether texts of several types share a string pool, off only by tests comparing without it
+var sharePool = true
+
+// Generator holds the state of the analysis. Primarily used to buffer
+// the output for format.Source.
+type Generator struct {
+	buf           bytes.Buffer           // Accumulated output.
+	pkg           *srcPackage            // Package we are scanning.
+	parser        *parser                // toml file parser
+	values        map[string][]Value     // parse source code for TYPE CONST values map[typ][]Value
+	basicType     map[string]string      // parse source code for TYPE  map[typ]basicType, for {"ErrCode": "uint32"}
+	catalog       map[string]catalogType // catalog asset for embed mode map[typ]catalogType
+	assetOwner    string                 // type name the embedded catalog asset variable named after
+	locales       []string               // locales generated into buf, the core file only has the default one when split
+	transFunc     string                 // name of the translate one CONST method generated into buf
+	localeFiles   []*Generator           // generators of split locale files, one per none default locale
+	splitFile     bool                   // tables of buf only have one locale when split
+	pool          *Pool                  // shared string pool of buf, nil when each type has its own name strings
+	logf          func(format string, args ...interface{})
+	errs          *errorCollector // problems found by Load
+	ctxKey        string
+	defaultLocale string
+	mode          string
+	trimPrefix    string
+	lineComment   bool
+	bitmask       bool
+	showKeys      bool
+}
+
+func (g *Generator) Printf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(&g.buf, format, args...)
+}
+
+// srcFile holds a single parsed file and associated data.
+type srcFile struct {
+	pkg  *srcPackage // Package to which this file belongs.
+	file *ast.File   // Parsed AST.
+	// These fields are reset for each type being generated.
+	typeName string  // Name of the constant type.
+	values   []Value // Accumulator for constant values of that type.
+
+	trimPrefix  string
+	lineComment bool
+	errs        *errorCollector // problems found by the walker
+}
+
+// srcPackage holds a type checked package and its parsed files.
+type srcPackage struct {
+	name  string
+	fset  *token.FileSet
+	defs  map[*ast.Ident]types.Object
+	scope *types.Scope
+	files []*srcFile
+}
+
+// loadPackages analyzes all packages matched by the patterns and tags with a single load.
+func loadPackages(patterns []string, tags []string) ([]*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode:       packages.NeedName | packages.NeedFiles | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedSyntax,
+		Tests:      false,
+		BuildFlags: []string{fmt.Sprintf("-tags=%s", strings.Join(tags, " "))},
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) == 0 {
+		return nil, fmt.Errorf("error: no packages found by %s", strings.Join(patterns, " "))
+	}
+	return pkgs, nil
+}
+
+// declaredTypes returns the types of typeItems declared in pkg, in the order of typeItems.
+func declaredTypes(pkg *packages.Package, typeItems []string) []string {
+	var items []string
+	for _, typeName := range typeItems {
+		if pkg.Types == nil || len(pkg.GoFiles) == 0 {
+			break
+		}
+		if _, ok := pkg.Types.Scope().Lookup(typeName).(*types.TypeName); ok {
+			items = append(items, typeName)
+		}
+	}
+	return items
+}
+
+// addPackage adds a type checked Package and its syntax files to the generator.
+func (g *Generator) addPackage(pkg *packages.Package) {
+	g.pkg = &srcPackage{
+		name:  pkg.Name,
+		fset:  pkg.Fset,
+		defs:  pkg.TypesInfo.Defs,
+		scope: pkg.Types.Scope(),
+		files: make([]*srcFile, len(pkg.Syntax)),
+	}
+
+	for i, file := range pkg.Syntax {
+		g.pkg.files[i] = &srcFile{
+			file:        file,
+			pkg:         g.pkg,
+			trimPrefix:  g.trimPrefix,
+			lineComment: g.lineComment,
+			errs:        g.errs,
+		}
+	}
+}
+
+// typePos returns the position of the declaration of type typeName, zero when it is not declared
+func (g *Generator) typePos(typeName string) token.Position {
+	obj := g.pkg.scope.Lookup(typeName)
+	if obj == nil {
+		return token.Position{}
+	}
+	return g.pkg.fset.Position(obj.Pos())
+}
+
+// parseConstValues parse const value to g
+func (g *Generator) parseConstValues(typeName string) {
+	found := len(g.errs.list)
+	g.values[typeName] = make([]Value, 0, 100)
+	for _, file := range g.pkg.files {
+		// Set the state for this run of the walker.
+		file.typeName = typeName
+		file.values = nil
+		if file.file != nil {
+			ast.Inspect(file.file, file.genDecl)
+			g.values[typeName] = append(g.values[typeName], file.values...)
+
+			// set typ basic TYPE, for int,int64,uint,uint8 etc
+			if _, ok := g.basicType[typeName]; !ok && file.values != nil {
+				g.basicType[typeName] = file.values[0].basicType
+			}
+		}
+	}
+
+	// constants with problems are not counted
+	if len(g.values[typeName]) == 0 && len(g.errs.list) == found {
+		g.errs.add(token.Position{}, "No CONST values defined for type %s", typeName)
+	}
+}
+
+// generate produces the String method for the named type.
+func (g *Generator) generate(typeName string) {
+	//values := make([]Value, 0, 100)
+	//for _, file := range g.pkg.files {
+	//	// Set the state for this run of the walker.
+	//	file.typeName = typeName
+	//	file.values = nil
+	//	if file.file != nil {
+	//		ast.Inspect(file.file, file.genDecl)
+	//		values = append(values, file.values...)
+	//	}
+	//}
+	//
+	//if len(values) == 0 {
+	//	log.Fatalf("no values defined for type %s", typeName)
+	//}
+
+	// splitIntoRuns sorts and deduplicates in place, the parsed values are kept for later runs
+	values := append([]Value(nil), g.values[typeName]...)
+
+	// Generate code that will fail if the constants change value.
+	g.Printf("func _() {\n")
+	g.Printf("\t// An \"invalid array index\" compiler error signifies that the constant values have changed.\n")
+	g.Printf("\t// Re-run the i18n-stringer command to generate them again.\n")
+	g.Printf("\tvar x [1]struct{}\n")
+	for _, v := range values {
+		if v.isString() {
+			// A string can not be an index, only a changed length is caught here,
+			// the map of string types is keyed by the names and follows any value.
+			g.Printf("\t_ = x[len(%s) - len(%s)]\n", v.originalName, v.str)
+			continue
+		}
+		g.Printf("\t_ = x[%s - %s]\n", v.originalName, v.str)
+	}
+	g.Printf("}\n")
+
+	runs := splitIntoRuns(values)
+
+	// The decision of which pattern to use depends on the number of
+	// runs in the numbers. If there's only one, it's easy. For more than
+	// one, there's a tradeoff between complexity and size of the data
+	// and code vs. the simplicity of a map. A map takes more space,
+	// but so does the code. The decision here (crossover at 10) is
+	// arbitrary, but considers that for large numbers of runs the cost
+	// of the linear scan in the switch might become important, and
+	// rather than use yet another algorithm such as binary search,
+	// we punt and use a map. In any case, the likelihood of a map
+	// being necessary for any realistic example other than bitmasks
+	// is very low. Bitmasks get their own analysis with -bitmask, where
+	// values that are not defined are translated bit by bit, see buildBitmask.
+	g.buildTransOne(runs, typeName)
+
+	// build split locale files, which register themselves at init time
+	if len(g.localeFiles) > 0 {
+		g.Printf(splitLocaleDispatch, typeName, g.transFunc)
+		fmtValues := g.fmtValues(runs)
+		for _, lg := range g.localeFiles {
+			lg.buildTransOne(runs, typeName)
+			lg.Printf(splitLocaleRegister, typeName, lg.locales[0], lg.transFunc, lg.fmtTable(fmtValues, typeName))
+		}
+	}
+
+	// build locale support set
+	g.buildLocaleSet(typeName)
+
+	// build common function
+	g.buildCommFunc(typeName)
+
+	// build placeholder fragment check
+	g.buildFragment(runs, typeName)
+
+	// build bit by bit translation of combined values
+	if g.bitmask {
+		g.buildBitmask(runs, typeName)
+	}
+
+	// build i18n trans func
+	g.buildI18nTransFunc(runs, typeName)
+
+	// build debug mode showing the constants instead of translations
+	if g.showKeys {
+		g.buildShowKeys(runs, typeName)
+	}
+}
+
+// buildTransOne produces the translate one CONST method for locales of g.
+func (g *Generator) buildTransOne(runs [][]Value, typeName string) {
+	// The embed mode does not generate any name table at all, the embedded
+	// catalog asset is looked up by a binary search over the sorted values.
+	// String types have no runs at all, a map is the only choice.
+	switch {
+	case g.mode == ModeEmbed:
+		g.buildEmbed(runs, typeName)
+	case g.basicType[typeName] == "string":
+		g.buildMap(runs, typeName)
+	case len(runs) == 1:
+		g.buildOneRun(runs, typeName)
+	case len(runs) <= 10:
+		g.buildMultipleRuns(runs, typeName)
+	default:
+		g.buildMap(runs, typeName)
+	}
+}
+
+// Arguments to format are:
+//	[1]: type name
+//	[2]: translate one CONST method name of default locale
+const splitLocaleDispatch = `
+// _%[1]s_transLocale translate one CONST method of each supported locale indexed by _%[1]s_supported,
+// locales in split files built with their build tag register themselves at init time
+var _%[1]s_transLocale = []func(%[1]s, int) string{%[1]s.%[2]s}
+
+// _transIdx translate one CONST with locale index
+func (i %[1]s) _transIdx(li int) string {
+	return _%[1]s_transLocale[li](i, li)
+}
+`
+
+// Arguments to format are:
+//	[1]: type name
+//	[2]: locale name
+//	[3]: translate one CONST method name of the locale
+//	[4]: segments of the translations with fmt verbs of the locale
+const splitLocaleRegister = `
+// register locale %[2]s of type %[1]s
+func init() {
+	_%[1]s_supported["%[2]s"] = len(_%[1]s_locales)
+	_%[1]s_locales = append(_%[1]s_locales, "%[2]s")
+	_%[1]s_transLocale = append(_%[1]s_transLocale, %[1]s.%[3]s)
+	_%[1]s_fmt = append(_%[1]s_fmt, %[4]s...)
+}
+`
+
+// localeTag returns the build tag of a split locale file, for zh-HK is i18n_zh_hk
+func localeTag(locale string) string {
+	tag := []byte(strings.ToLower(locale))
+	for i, c := range tag {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			tag[i] = '_'
+		}
+	}
+	return "i18n_" + string(tag)
+}
+
+// splitIntoRuns breaks the values into runs of contiguous sequences.
+// For example, given 1,2,3,5,6,7 it returns {1,2,3},{5,6,7}.
+// The input slice is known to be non-empty.
+func splitIntoRuns(values []Value) [][]Value {
+	// We use stable sort so the lexically first name is chosen for equal elements.
+	sort.Stable(byValue(values))
+	// Remove duplicates. Stable sort has put the one we want to print first,
+	// so use that one. The String method won't care about which named constant
+	// was the argument, so the first name for the given value is the only one to keep.
+	// We need to do this because identical values would cause the switch or map
+	// to fail to compile.
+	j := 1
+	for i := 1; i < len(values); i++ {
+		if values[i].value != values[i-1].value || values[i].strVal != values[i-1].strVal {
+			values[j] = values[i]
+			j++
+		}
+	}
+	values = values[:j]
+	runs := make([][]Value, 0, 10)
+	for len(values) > 0 {
+		// One contiguous sequence per outer loop.
+		i := 1
+		for i < len(values) && values[i].value == values[i-1].value+1 {
+			i++
+		}
+		runs = append(runs, values[:i])
+		values = values[i:]
+	}
+	return runs
+}
+
+// format returns the gofmt-ed contents of the Generator's buffer, an error when the
+// generated source of file name is invalid, which is a bug of the generator.
+func (g *Generator) format(name string) ([]byte, error) {
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("internal error: invalid Go generated for %s: %s", name, err)
+	}
+	return src, nil
+}
@@ -0,0 +1,439 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// loadProbe set in the environment of the child process run by loadable
+const loadProbe = "I18N_STRINGER_LOAD_PROBE"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(loadProbe) != "" {
+		if _, err := loadPackages([]string{"../test/test_fragments/typ.go"}, nil); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// loaderErr why packages can not be loaded, probed once by loadable
+var (
+	loaderOnce sync.Once
+	loaderErr  error
+)
+
+// loadable skips the test when golang.org/x/tools can not load packages with the Go toolchain running
+// the tests, such as an old x/tools crashing on a newer toolchain, probed in a child process.
+func loadable(t *testing.T) {
+	t.Helper()
+	loaderOnce.Do(func() {
+		cmd := exec.Command(os.Args[0], "-test.run=^$")
+		cmd.Env = append(os.Environ(), loadProbe+"=1")
+		loaderErr = cmd.Run()
+	})
+	if loaderErr != nil {
+		t.Skipf("golang.org/x/tools can not load packages with %s: %s", runtime.Version(), loaderErr)
+	}
+}
+
+// fixture returns the Go files of the fixture dir of ../test which are not generated by i18n-stringer,
+// so that the fixture is loaded as its files whatever state its generated files are in
+func fixture(t *testing.T, dir string) []string {
+	t.Helper()
+	names, err := filepath.Glob(filepath.Join("..", "test", dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []string
+	for _, name := range names {
+		src, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(src, []byte("// Code generated by \"i18n-stringer")) && !strings.HasSuffix(name, "_test.go") {
+			files = append(files, name)
+		}
+	}
+	if len(files) == 0 {
+		t.Fatalf("no Go files in fixture %s", dir)
+	}
+	return files
+}
+
+// loadFixture loads the fixture dir of ../test with cfg, Patterns set to its files
+func loadFixture(t *testing.T, dir string, cfg Config) *Package {
+	t.Helper()
+	loadable(t)
+	cfg.Patterns = fixture(t, dir)
+	pkgs, err := Load(cfg)
+	if err != nil {
+		t.Fatalf("Load %s: %s", dir, err)
+	}
+	if len(pkgs) != 1 {
+		t.Fatalf("Load %s: %d packages, want 1", dir, len(pkgs))
+	}
+	return pkgs[0]
+}
+
+// generateCases the fixtures of ../test with the flags of their go:generate lines
+var generateCases = []struct {
+	dir string
+	cfg Config
+}{
+	{"test_bitmask", Config{Types: []string{"Perm"}, DefaultLocale: "en", Bitmask: true,
+		CommandLine: "-type Perm -defaultlocale en -bitmask"}},
+	{"test_directives", Config{Types: []string{"Code"}, DefaultLocale: "en", CommandLine: "-type Code -defaultlocale en"}},
+	{"test_embed", Config{Types: []string{"RuneOne", "RuneMulti", "RuneMap"}, Mode: ModeEmbed,
+		CommandLine: "-type RuneOne,RuneMulti,RuneMap -mode embed"}},
+	{"test_fragments", Config{Types: []string{"Code"}, DefaultLocale: "en", Fragments: "10000-20000", ShowKeys: true,
+		CommandLine: "-type Code -defaultlocale en -fragments 10000-20000 -showkeys"}},
+	{"test_gentest", Config{Types: []string{"Code"}, DefaultLocale: "en", LineComment: true, GenTest: true,
+		CommandLine: "-type Code -defaultlocale en -linecomment -gentest"}},
+	{"test_locales", Config{Types: []string{"Code"}, DefaultLocale: "en", CommandLine: "-type Code -defaultlocale en"}},
+	{"test_no_export", Config{Types: []string{"code_no_export"}, Output: "../test/test_no_export/stringer.go",
+		CommandLine: "-type code_no_export -output stringer.go"}},
+	{"test_split_locales", Config{Types: []string{"RuneOne", "RuneMulti", "RuneMap"}, SplitLocales: true,
+		CommandLine: "-type RuneOne,RuneMulti,RuneMap -splitlocales"}},
+	{"test_string_enum", Config{Types: []string{"Status", "Level"}, DefaultLocale: "en",
+		CommandLine: "-type Status,Level -defaultlocale en"}},
+	{"test_switch", Config{Types: []string{"RuneOne", "RuneMulti", "RuneMap"}, CommandLine: "-type RuneOne,RuneMulti,RuneMap"}},
+	{"test_trimprefix", Config{Types: []string{"ErrCode"}, DefaultLocale: "en", TrimPrefix: "ErrCode", LineComment: true,
+		CommandLine: "-type ErrCode -defaultlocale en -trimprefix ErrCode -linecomment"}},
+	{"test_use_define_path", Config{Types: []string{"Code", "Test", "Single"}, TomlPath: "../test/test_use_define_path/language",
+		Output: "../test/test_use_define_path/stringer.go", CommandLine: "-type Code,Test,Single -tomlpath language -output stringer.go"}},
+	{"test_use_dir", Config{Types: []string{"Code", "Test", "Single"}, CommandLine: "-type Code,Test,Single"}},
+	{"test_use_file", Config{Types: []string{"Code", "Test", "Single"}, CommandLine: "-type Code,Test,Single"}},
+	{"test_use_mix", Config{Types: []string{"Code", "Test", "Single"}, CommandLine: "-type Code,Test,Single"}},
+}
+
+// TestGenerate generates the fixtures of ../test again, the files must be the same as committed
+func TestGenerate(t *testing.T) {
+	for _, tc := range generateCases {
+		t.Run(tc.dir, func(t *testing.T) {
+			files, err := Generate(loadFixture(t, tc.dir, tc.cfg))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, file := range files {
+				want, err := os.ReadFile(file.Name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(file.Source, want) {
+					t.Errorf("generated %s differs from the committed file:\n%s", file.Name, Diff(file.Name, want, file.Source))
+				}
+			}
+		})
+	}
+}
+
+// TestGenTestMissing checks that the generated test lists the locales without a TOML value
+func TestGenTestMissing(t *testing.T) {
+	files, err := Generate(loadFixture(t, "test_check_const", Config{Types: []string{"Code", "Test", "Single"}, GenTest: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test := files[len(files)-1]
+	if !strings.HasSuffix(test.Name, "_test.go") {
+		t.Fatalf("last generated file %s, want the test", test.Name)
+	}
+	for _, want := range []string{"{CodeOK, \"CodeOK\", []string{\"en\", \"zh-hk\"}},", "{Sig01, \"Sig01\", []string{\"zh-hk\"}},"} {
+		if !strings.Contains(string(test.Source), want) {
+			t.Errorf("generated test has no line %s", want)
+		}
+	}
+}
+
+// TestCheck counts the findings of the fixtures by kind
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		dir  string
+		cfg  Config
+		want map[Kind]int
+	}{
+		{"test_check_const", Config{Types: []string{"Code", "Test", "Single"}},
+			map[Kind]int{KindMissing: 132, KindUnused: 5, KindDuplicate: 2}},
+		{"test_check_const", Config{Types: []string{"Code", "Test", "Single"}, Severities: map[Kind]Severity{KindMissing: SeverityOff}},
+			map[Kind]int{KindUnused: 5, KindDuplicate: 2}},
+		{"test_fragments", Config{Types: []string{"Code"}, DefaultLocale: "en", Fragments: "10000-20000"},
+			map[Kind]int{KindFragment: 5}},
+		{"test_switch", Config{Types: []string{"RuneOne", "RuneMulti", "RuneMap"}}, map[Kind]int{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.dir, func(t *testing.T) {
+			got := make(map[Kind]int)
+			for _, item := range Check(loadFixture(t, tc.dir, tc.cfg)) {
+				got[item.Kind]++
+			}
+			for _, item := range kinds {
+				if got[item.kind] != tc.want[item.kind] {
+					t.Errorf("%d findings of kind %s, want %d", got[item.kind], item.kind, tc.want[item.kind])
+				}
+			}
+		})
+	}
+}
+
+// TestCheckFindings checks the findings other than missing of a fixture one by one
+func TestCheckFindings(t *testing.T) {
+	p := loadFixture(t, "test_check_const", Config{Types: []string{"Code", "Test", "Single"}})
+	var got []string
+	for _, item := range Check(p) {
+		if item.Kind != KindMissing {
+			got = append(got, string(item.Kind)+" "+item.Locale+" "+item.Key+" "+filepath.Base(item.Pos.Filename))
+		}
+	}
+	want := []string{
+		"unused en HELLO en.toml",
+		"duplicate en HELLO en.toml",
+		"unused en WORLD en.toml",
+		"duplicate en WORLD en.toml",
+		"unused zh-hk 43-EW_KySD.DS user.toml",
+		"unused zh-hk HELLO user.toml",
+		"unused zh-hk WORLD user.toml",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("findings:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// TestCheckOrder checks that findings of two types are in one order by type, locale and key,
+// whatever the order of Config.Types
+func TestCheckOrder(t *testing.T) {
+	var lists [2][]string
+	var findings []Finding
+	for n, types := range [][]string{{"Test", "Code"}, {"Code", "Test"}} {
+		findings = Check(loadFixture(t, "test_check_const", Config{Types: types}))
+		for _, item := range findings {
+			lists[n] = append(lists[n], strings.Join([]string{item.Type, item.Locale, item.Key, string(item.Kind)}, " "))
+		}
+	}
+	got := strings.Join(lists[0], "\n")
+	if got != strings.Join(lists[1], "\n") {
+		t.Errorf("findings of types Test,Code:\n%s\nof types Code,Test:\n%s", got, strings.Join(lists[1], "\n"))
+	}
+	ordered := sort.SliceIsSorted(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Locale != b.Locale {
+			return a.Locale < b.Locale
+		}
+		return a.Key < b.Key
+	})
+	if !ordered {
+		t.Errorf("findings not ordered by type, locale and key:\n%s", got)
+	}
+	for _, want := range []string{
+		"Code en CodeOK missing",
+		"Code zh-hk CodeXe3 missing",
+		"Test en TestCase01 missing",
+		"Test zh-hk TestCase06 missing",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("findings without %q:\n%s", want, got)
+		}
+	}
+}
+
+// TestPositions checks that positions in Go and TOML files are absolute, whatever the paths of Config
+func TestPositions(t *testing.T) {
+	p := loadFixture(t, "test_check_const", Config{Types: []string{"Code", "Test", "Single"}, TomlPath: "../test/test_check_const/i18n"})
+	kinds := make(map[Kind]bool)
+	for _, item := range Check(p) {
+		if !filepath.IsAbs(item.Pos.Filename) {
+			t.Errorf("%s %s %s at %s, want absolute path", item.Kind, item.Locale, item.Key, item.Pos)
+		}
+		kinds[item.Kind] = true
+	}
+	if !kinds[KindMissing] || !kinds[KindUnused] {
+		t.Errorf("findings of kinds %v, want positions of both Go and TOML files", kinds)
+	}
+
+	loadable(t)
+	_, err := Load(Config{Types: []string{"Code"}, Patterns: []string{"testdata/badtoml/typ.go"}, Allowlist: "testdata/none.txt"})
+	var list ErrorList
+	if !errors.As(err, &list) || len(list) != 2 {
+		t.Fatalf("Load error %v, want 2 errors", err)
+	}
+	for _, item := range list {
+		if !filepath.IsAbs(item.Pos.Filename) {
+			t.Errorf("error %s, want absolute path", item)
+		}
+	}
+}
+
+// TestLoadErrors checks that Load returns the problems of options and files instead of exiting
+func TestLoadErrors(t *testing.T) {
+	fragments := fixture(t, "test_fragments")
+	tests := []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{"no type", Config{}, "no type set"},
+		{"mode", Config{Types: []string{"Code"}, Mode: "lazy"}, "-mode option only supports"},
+		{"split embed", Config{Types: []string{"Code"}, Mode: ModeEmbed, SplitLocales: true}, "-splitlocales option can not be used with -mode embed"},
+		{"fragments", Config{Types: []string{"Code"}, Fragments: "20-10"}, "20-10"},
+		{"fixfill", Config{Types: []string{"Code"}, FixFill: "todo"}, "-fixfill option only supports"},
+		{"fixfile", Config{Types: []string{"Code"}, FixFile: "i18n/en.toml"}, "-fixfile option must be a TOML file name"},
+		{"severity", Config{Types: []string{"Code"}, Severities: map[Kind]Severity{KindUnused: "fatal"}}, "severity `fatal` of kind `unused` is unknown"},
+		{"kind", Config{Types: []string{"Code"}, Severities: map[Kind]Severity{"typo": SeverityOff}}, "kind `typo` is unknown"},
+		{"default locale", Config{Types: []string{"Code"}, Patterns: fragments, DefaultLocale: "fr"}, "The locale `fr` by -defaultlocale is not found"},
+		{"toml path", Config{Types: []string{"Code"}, Patterns: fragments, TomlPath: "testdata/none"}, "testdata/none"},
+		{"bad toml", Config{Types: []string{"Code"}, Patterns: []string{"testdata/badtoml/typ.go"}},
+			"testdata/badtoml/i18n/en.toml:2:10: value of key `CodeFail` must be using double quotes"},
+		{"fragments table", Config{Types: []string{"Code"}, Patterns: []string{"testdata/badfragments/typ.go"}},
+			"testdata/badfragments/i18n/zh-cn.toml:2:1: key `CodeName` is under table [fragments] of locale `en` but not of locale `zh-cn`"},
+		{"fragments redefined", Config{Types: []string{"Code"}, Patterns: []string{"testdata/refragments/typ.go"}},
+			"testdata/refragments/i18n/en/b.toml:1:1: key `CodeName` of locale `en` is defined again outside table [fragments]"},
+		{"bitmask string", Config{Types: []string{"Status"}, Patterns: fixture(t, "test_string_enum"), Bitmask: true},
+			"test_string_enum/typ.go:5:6: -bitmask option can not be used with string type Status"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if len(tc.cfg.Patterns) > 0 {
+				loadable(t)
+			}
+			_, err := Load(tc.cfg)
+			if err == nil {
+				t.Fatalf("Load succeeded, want error %q", tc.want)
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("Load error %q, want %q", err, tc.want)
+			}
+			if strings.Contains(err.Error(), "locale ``") {
+				t.Errorf("Load error %q names no locale", err)
+			}
+		})
+	}
+}
+
+// TestSharePool generates a fixture of several types with and without the shared string pool,
+// the pooled file must be smaller and every constant must translate the same in every locale
+func TestSharePool(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated packages")
+	}
+	dir, cfg := "test_use_dir", Config{Types: []string{"Code", "Test", "Single"}}
+	p := loadFixture(t, dir, cfg)
+	pooled, err := Generate(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sharePool = false
+	defer func() { sharePool = true }()
+	unpooled, err := Generate(loadFixture(t, dir, cfg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pooled[0].Source) >= len(unpooled[0].Source) {
+		t.Errorf("generated %d bytes with the pool, %d bytes without", len(pooled[0].Source), len(unpooled[0].Source))
+	}
+
+	// a test printing the text of every constant in every locale
+	dump := new(bytes.Buffer)
+	dump.WriteString("package " + dir + "\n\nimport (\n\t\"fmt\"\n\t\"testing\"\n)\n\nfunc TestDump(t *testing.T) {\n")
+	for _, typeName := range cfg.Types {
+		for _, value := range p.g.values[typeName] {
+			for _, locale := range p.g.parser.locales {
+				_, _ = fmt.Fprintf(dump, "\tfmt.Printf(\"dump %%s %%s %%q\\n\", %[1]q, %[2]q, %[1]s.Trans(%[2]q))\n", value.originalName, locale)
+			}
+		}
+	}
+	dump.WriteString("}\n")
+	if got, want := runDump(t, dir, unpooled, dump.Bytes()), runDump(t, dir, pooled, dump.Bytes()); got != want {
+		t.Errorf("translations differ without the pool:\n%s", Diff("dump", []byte(want), []byte(got)))
+	}
+}
+
+// runDump builds the fixture dir of ../test with the generated files and the test dump in a
+// module of its own, returns the lines printed by the test
+func runDump(t *testing.T, dir string, files []File, dump []byte) string {
+	t.Helper()
+	tmp := t.TempDir()
+	write := func(name string, src []byte) {
+		if err := os.WriteFile(filepath.Join(tmp, name), src, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("go.mod", []byte("module "+dir+"\n\ngo 1.18\n"))
+	write("dump_test.go", dump)
+	for _, name := range fixture(t, dir) {
+		src, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		write(filepath.Base(name), src)
+	}
+	for _, file := range files {
+		write(filepath.Base(file.Name), file.Source)
+	}
+	cmd := exec.Command("go", "test", "-count=1", "-run=^TestDump$", "-v", ".")
+	cmd.Dir = tmp
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go test of the generated %s: %s\n%s", dir, err, out)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.HasPrefix(line, "dump ") {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		t.Fatalf("go test of the generated %s printed nothing:\n%s", dir, out)
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// TestPrune prunes the unused keys of a fixture whatever the severity of KindUnused
+func TestPrune(t *testing.T) {
+	for _, severity := range []Severity{SeverityWarning, SeverityOff} {
+		t.Run("unused="+string(severity), func(t *testing.T) {
+			cfg := Config{Types: []string{"Code", "Test", "Single"}, Severities: map[Kind]Severity{KindUnused: severity}}
+			files, err := Prune([]*Package{loadFixture(t, "test_check_const", cfg)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, file := range files {
+				for _, line := range strings.Split(string(file.Source), "\n") {
+					for _, key := range []string{"HELLO", "WORLD", "43-EW_KySD.DS"} {
+						if strings.HasPrefix(line, key) {
+							t.Errorf("unused key %s kept in %s", key, file.Name)
+						}
+					}
+				}
+				got = append(got, file.Name)
+			}
+			var want []string
+			for _, name := range []string{"en.toml", "en/en.toml", "zh-hk/user.toml"} {
+				abs, err := filepath.Abs(filepath.Join("..", "test", "test_check_const", "i18n", name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				want = append(want, abs)
+			}
+			if strings.Join(got, "\n") != strings.Join(want, "\n") {
+				t.Errorf("pruned files:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+			}
+		})
+	}
+}
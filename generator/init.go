@@ -0,0 +1,126 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// layout of the TOML files written by Init
+const (
+	LayoutFile = "file" // a single file per locale such as i18n/en.toml
+	LayoutDir  = "dir"  // a subdirectory per locale with a file per type such as i18n/en/code.toml
+)
+
+// Init returns the TOML files bootstrapping the catalogs of the packages of cfg from their constants,
+// in the i18n directory of each package or Config.TomlPath, no TOML file is needed to load them.
+// The file of Config.DefaultLocale, default the first of locales, has the doc comment of each constant
+// or its line comment as text, the files of the other locales are skeletons with empty values. Keys
+// without text are marked by a # TODO(translate) comment as Fix does, keys are ordered by the values
+// of the constants. Files that exist are left out and noticed by Config.Logf, so that Init only
+// bootstraps, use Fix to add the keys of new constants to them.
+func Init(cfg Config, locales []string, layout string) ([]File, error) {
+	if layout == "" {
+		layout = LayoutFile
+	}
+	if layout != LayoutFile && layout != LayoutDir {
+		return nil, fmt.Errorf("-layout option only supports `%s` or `%s`, got `%s`", LayoutFile, LayoutDir, layout)
+	}
+	defaultLocale := cfg.DefaultLocale
+	if defaultLocale == "" && len(locales) > 0 {
+		defaultLocale = locales[0]
+	}
+	if defaultLocale == "" {
+		return nil, errors.New("no locale set, set -locales or -defaultlocale")
+	}
+	all := []string{defaultLocale}
+	for _, locale := range locales {
+		if locale != defaultLocale {
+			all = append(all, locale)
+		}
+	}
+
+	pkgs, err := loadAll(cfg, false)
+	if err != nil {
+		return nil, err
+	}
+	// packages sharing a TOML directory are bootstrapped together
+	var dirs []string
+	groups := make(map[string][]*Package)
+	for _, p := range pkgs {
+		if groups[p.g.parser.path] == nil {
+			dirs = append(dirs, p.g.parser.path)
+		}
+		groups[p.g.parser.path] = append(groups[p.g.parser.path], p)
+	}
+	var res []File
+	for _, dir := range dirs {
+		for _, locale := range all {
+			for _, file := range initFiles(dir, groups[dir], locale, locale == defaultLocale, layout) {
+				if _, err = os.Stat(file.Name); err == nil {
+					groups[dir][0].g.logf("TOML file `%s` exists and is not bootstrapped, use -fix to add missing key-value pairs", file.Name)
+					continue
+				}
+				res = append(res, file)
+			}
+		}
+	}
+	return res, nil
+}
+
+// initFiles returns the TOML files of locale bootstrapping the catalog in dir of the types of pkgs,
+// the texts are the doc comments of the constants for the default locale
+func initFiles(dir string, pkgs []*Package, locale string, isDefault bool, layout string) []File {
+	var types []string
+	var values [][]Value
+	for _, p := range pkgs {
+		for _, typeName := range p.Types {
+			items := make([]Value, len(p.g.values[typeName]))
+			copy(items, p.g.values[typeName])
+			sort.Stable(byValue(items))
+			types, values = append(types, typeName), append(values, items)
+		}
+	}
+
+	var res []File
+	var b strings.Builder
+	for i, typeName := range types {
+		if layout == LayoutFile && len(types) > 1 {
+			if b.Len() > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString("# " + typeName + "\n")
+		}
+		for _, value := range values[i] {
+			text := ""
+			if isDefault {
+				text = value.doc
+			}
+			switch {
+			case text == "" && value.note != "":
+				b.WriteString(todoComment + ": " + value.note + "\n")
+			case text == "":
+				b.WriteString(todoComment + "\n")
+			case value.note != "":
+				b.WriteString("# " + value.note + "\n")
+			}
+			b.WriteString(value.key + "=" + quoteTOML(text) + "\n")
+		}
+		if layout == LayoutDir {
+			name := filepath.Join(dir, locale, strings.ToLower(typeName)+".toml")
+			res = append(res, File{Name: name, Source: []byte(b.String())})
+			b.Reset()
+		}
+	}
+	if layout == LayoutFile {
+		res = append(res, File{Name: filepath.Join(dir, locale+".toml"), Source: []byte(b.String())})
+	}
+	return res
+}
@@ -0,0 +1,395 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TOML tables with a meaning
+const (
+	fragmentTable = "fragments" // keys are placeholder fragments
+	bitmaskTable  = "bitmask"   // settings of bitmask types, only key separator
+)
+
+// parser locale config file parser
+type parser struct {
+	mu         sync.RWMutex
+	files      map[string][]string                  // toml file, locale to file list map
+	locales    []string                             // naturally sorted, if not specify default locale, first index used
+	localesMap map[string]map[string]string         // {"locale":{"tran-key": "tran-val", "tran-key1": "tran-val1"}} case-insensitive
+	fragments  map[string]map[string]bool           // locales defining keys in TOML table [fragments]: map[key][locale]
+	separators map[string]string                    // bitmask separator of locale defined in TOML table [bitmask]
+	positions  map[string]map[string]token.Position // position of key-value pairs: map[locale][key]
+	tables     map[string]map[string]string         // TOML table of key-value pairs under one: map[locale][key]
+	duplicates []duplicate                          // keys defined again, in the order of files and lines
+	pseudo     string                               // pseudo locale added by addPseudo, without TOML files
+	path       string                               // config file belong path
+	errs       *errorCollector                      // problems of TOML files
+
+	// logf notices of ignored files and duplicate keys
+	logf func(format string, args ...interface{})
+}
+
+// duplicate one key defined again in TOML of a locale
+type duplicate struct {
+	locale string
+	key    string
+	pos    token.Position // the key defined again
+}
+
+// newParser new instance for parser
+func newParser(path string, logf func(format string, args ...interface{}), errs *errorCollector) *parser {
+	return &parser{
+		files:      make(map[string][]string, 0),
+		locales:    make([]string, 0),
+		localesMap: make(map[string]map[string]string, 0),
+		fragments:  make(map[string]map[string]bool, 0),
+		separators: make(map[string]string, 0),
+		positions:  make(map[string]map[string]token.Position, 0),
+		tables:     make(map[string]map[string]string, 0),
+		path:       path,
+		errs:       errs,
+		logf:       logf,
+	}
+}
+
+// HasLocaleValue whether the specified key in the specified locale defined by TOML
+func (p *parser) HasLocaleValue(key, locale string) bool {
+	_, exist := p.localesMap[locale][key]
+	return exist
+}
+
+// GetLocaleValue Get the value of the specified key in the specified locale defined by TOML
+// If it doesn't exist, return the key value itself
+func (p *parser) GetLocaleValue(key, locale string) string {
+	if items, ok := p.localesMap[locale]; ok {
+		if item, exist := items[key]; exist {
+			return item
+		}
+	}
+	return key
+}
+
+// parse parse toml config file
+// toml file just support utf8 K/V mode
+//  - CodeErr="aaa"
+//  - CodeErr1="aaa\"\n execute"
+//
+// reports whether any TOML file found, problems of them are added to the errors of p
+func (p *parser) parse() bool {
+	if isDir, err := isDirectory(p.path); err != nil {
+		p.errs.add(token.Position{}, "%s", err)
+		return false
+	} else if !isDir {
+		p.errs.add(token.Position{Filename: p.path}, "-tomlpath option applies only to directory, eg. i18n")
+		return false
+	}
+
+	// parse toml file dir list
+	dir, err := os.ReadDir(p.path)
+	if err != nil {
+		p.errs.add(token.Position{}, "%s", err)
+		return false
+	}
+	for _, target := range dir {
+		if target.IsDir() {
+			locale := target.Name()
+			subDir := p.path + "/" + target.Name()
+			p.appendTomlFiles(locale, p.listSubDir(subDir))
+		} else {
+			// just collect .toml suffix file
+			if strings.HasSuffix(target.Name(), ".toml") {
+				locale := strings.TrimSuffix(target.Name(), ".toml")
+				fileDir := []string{p.path + "/" + target.Name()}
+				p.appendTomlFiles(locale, fileDir)
+			} else {
+				p.logf("Use only TOML format files, `%s` is ignored\n", p.path+"/"+target.Name())
+			}
+		}
+	}
+
+	// notice if toml none
+	if len(p.files) <= 0 {
+		p.errs.add(token.Position{Filename: p.path}, "No valid TOML file found, please write lacale TOML file at first")
+		return false
+	}
+
+	for locale := range p.files {
+		p.locales = append(p.locales, locale)
+	}
+
+	// naturally sorted
+	sort.Sort(sort.StringSlice(p.locales))
+
+	// parse then read toml file K/V
+	p.readToml2KV()
+	return true
+}
+
+// appendTomlFiles collect toml file with locale
+func (p *parser) appendTomlFiles(locale string, files []string) {
+	if len(files) <= 0 {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.files[locale]; ok {
+		p.files[locale] = append(p.files[locale], files...)
+	} else {
+		p.files[locale] = files
+	}
+}
+
+// listSubDir list toml sub dir
+func (p *parser) listSubDir(subPath string) []string {
+	res := make([]string, 0)
+	_ = filepath.WalkDir(subPath, func(path string, d fs.DirEntry, err error) error {
+		// just collect .toml suffix file
+		if err == nil && !d.IsDir() {
+			if strings.HasSuffix(path, ".toml") {
+				res = append(res, path)
+			} else {
+				p.logf("Use only TOML format files, `%s` is ignored\n", path)
+			}
+		}
+		return nil
+	})
+	return res
+}
+
+// readToml2KV read all toml file to K/V
+func (p *parser) readToml2KV() {
+	for _, locale := range p.locales {
+		for _, file := range p.files[locale] {
+			p.readOneToml(file, locale)
+		}
+	}
+	p.checkFragments()
+}
+
+// checkFragments reports keys under table [fragments] in some locales but not in others defining them,
+// a fragment in one locale is a fragment in all of them, so Wrap of its constants would panic at runtime
+func (p *parser) checkFragments() {
+	keys := make([]string, 0, len(p.fragments))
+	for key := range p.fragments {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		var in []string
+		for _, locale := range p.locales {
+			if p.fragments[key][locale] {
+				in = append(in, locale)
+			}
+		}
+		for _, locale := range p.locales {
+			if p.HasLocaleValue(key, locale) && !p.fragments[key][locale] {
+				p.errs.add(p.positions[locale][key], "key `%s` is under table [%s] of locale `%s` but not of locale `%s`",
+					key, fragmentTable, strings.Join(in, "`, `"), locale)
+			}
+		}
+	}
+}
+
+// readOneToml read one toml file, problems of lines are added to the errors of p with their position
+func (p *parser) readOneToml(path, locale string) {
+	stream, err := os.ReadFile(path)
+	if err != nil {
+		p.errs.add(token.Position{Filename: path}, "read TOML file occur err %s", err.Error())
+		return
+	}
+
+	// Read over BOM
+	data := string(stream)
+	if strings.HasPrefix(data, "\xff\xfe") || strings.HasPrefix(data, "\xfe\xff") {
+		data = data[2:]
+	}
+
+	// TOML files must be UTF-8
+	ex := 6
+	if len(data) < 6 {
+		ex = len(data)
+	}
+	if i := strings.IndexRune(data[:ex], 0); i > -1 {
+		p.errs.add(token.Position{Filename: path}, "TOML file must be using UTF-8 coding")
+		return
+	}
+
+	lines := strings.Split(data, "\n")
+	table := "" // current TOML table, keys of table [fragments] are placeholder fragments
+	for i := 0; i < len(lines) && !p.errs.full(); i++ {
+		var line = strings.Trim(lines[i], " \t\n\r")
+		if len(line) == 0 {
+			continue
+		}
+
+		// COMMENT to be ignore, SECTION only remembered
+		if line[0] == '#' {
+			continue
+		}
+		if line[0] == '[' && line[len(line)-1] == ']' {
+			table = strings.Trim(line[1:len(line)-1], " \t")
+			continue
+		}
+
+		// parse Key
+		idx := strings.Index(line, "=")
+		if idx < 0 {
+			continue
+		}
+		key := strings.Trim(line[0:idx], " \t\n\r") // trim space\tab\newLine
+
+		// parse Value
+		value := strings.Trim(line[idx+1:], " \t\n\r") // trim space\tab\newLine, may be empty string
+		if len(value) != 0 {
+			// check all value must be use double quotes
+			if value[0] != '"' {
+				p.errs.add(linePosition(path, i, lines[i], true), "value of key `%s` must be using double quotes", key)
+				continue
+			}
+			pValue, ok := UnquoteTOML(value)
+			if !ok {
+				p.errs.add(linePosition(path, i, lines[i], true), "value of key `%s` parse faild, backslash(\\) may be used incorrectly", key)
+				continue
+			}
+			value = pValue
+		}
+
+		// settings of bitmask types are not translations
+		if table == bitmaskTable {
+			if key != "separator" {
+				p.errs.add(linePosition(path, i, lines[i], false), "key `%s` of table [%s] is unknown, only separator supported", key, bitmaskTable)
+				continue
+			}
+			p.separators[locale] = value
+			continue
+		}
+
+		// set to map
+		if _, exist := p.localesMap[locale]; !exist {
+			p.localesMap[locale] = make(map[string]string, 0)
+			p.positions[locale] = make(map[string]token.Position, 0)
+		}
+
+		// check key exist then notice
+		if _, exist := p.localesMap[locale][key]; exist {
+			p.logf("Duplicate key-value pairs for key `%s` at file `%s` with locale `%s`", key, path, locale)
+			p.duplicates = append(p.duplicates, duplicate{locale: locale, key: key, pos: linePosition(path, i, lines[i], false)})
+
+			// a placeholder fragment in one definition only, reported here as checkFragments compares locales
+			if wasFragment := p.tables[locale][key] == fragmentTable; wasFragment != (table == fragmentTable) {
+				where := "outside"
+				if !wasFragment {
+					where = "under"
+				}
+				p.errs.add(linePosition(path, i, lines[i], false), "key `%s` of locale `%s` is defined again %s table [%s]",
+					key, locale, where, fragmentTable)
+			}
+		}
+		p.localesMap[locale][key] = value
+		p.positions[locale][key] = linePosition(path, i, lines[i], false)
+		if table != "" {
+			if _, exist := p.tables[locale]; !exist {
+				p.tables[locale] = make(map[string]string, 0)
+			}
+			p.tables[locale][key] = table
+		} else {
+			delete(p.tables[locale], key)
+		}
+		if table == fragmentTable {
+			if _, exist := p.fragments[key]; !exist {
+				p.fragments[key] = make(map[string]bool, 0)
+			}
+			p.fragments[key][locale] = true
+		}
+	}
+}
+
+// linePosition returns the position of the key, or the value after = of line i of a TOML file
+func linePosition(path string, i int, line string, value bool) token.Position {
+	start := 0
+	if value {
+		start = strings.Index(line, "=") + 1
+	}
+	rest := line[start:]
+	return token.Position{Filename: path, Line: i + 1, Column: start + len(rest) - len(strings.TrimLeft(rest, " \t")) + 1}
+}
+
+// UnquoteTOML returns the value of the TOML basic string s such as "a\"b", anything after
+// the closing quote is ignored, reports false when an escape sequence is not supported.
+func UnquoteTOML(s string) (string, bool) {
+	value, _, ok := parseString(s)
+	return value, ok
+}
+
+// quoteTOML returns s as a TOML basic string, the reverse of UnquoteTOML
+func quoteTOML(s string) string {
+	r := strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n", "\r", "\\r", "\t", "\\t", "\x00", "\\0")
+	return "\"" + r.Replace(s) + "\""
+}
+
+// parseString trans value, returns the byte offset after the closing quote, 0 when it is not closed
+func parseString(s string) (string, int, bool) {
+	if len(s) <= 0 {
+		return "", 0, true // allow empty value
+	}
+
+	index := 0
+	escape := false
+	result := ""
+	state := 0 // 0 = left, 1 = inside
+	for i, c := range s {
+		if state == 0 {
+			if c != '"' {
+				return "", 0, false
+			}
+			state = 1
+			continue
+		}
+
+		if state == 1 {
+			if escape {
+				if c == '0' {
+					result += "\x00"
+				} else if c == 't' {
+					result += "\t"
+				} else if c == 'n' {
+					result += "\n"
+				} else if c == 'r' {
+					result += "\r"
+				} else if c == '"' {
+					result += "\""
+				} else if c == '\\' {
+					result += "\\"
+				} else {
+					return "", 0, false
+				}
+				escape = false
+				continue
+			}
+
+			if c == '\\' {
+				escape = true
+				continue
+			}
+
+			if c == '"' && !escape {
+				index = i + 1
+				break
+			}
+
+			result += string(c)
+		}
+	}
+
+	return result, index, true
+}
@@ -0,0 +1,37 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestParseLocales checks that the locale of a TOML file is its name without the .toml suffix only,
+// locales such as it, lt, pl and pt end with letters of the suffix
+func TestParseLocales(t *testing.T) {
+	dir := t.TempDir()
+	files := []string{"en.toml", "it.toml", "lt.toml", "pl.toml", "pt.toml", "nl/code.toml"}
+	for _, name := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("CodeOK=\"ok\"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	errs := &errorCollector{}
+	p := newParser(dir, func(string, ...interface{}) {}, errs)
+	if !p.parse() || len(errs.list) > 0 {
+		t.Fatalf("parse failed: %v", errs.list)
+	}
+	want := []string{"en", "it", "lt", "nl", "pl", "pt"}
+	if !reflect.DeepEqual(p.locales, want) {
+		t.Errorf("locales %v, want %v", p.locales, want)
+	}
+}
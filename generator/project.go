@@ -0,0 +1,182 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ProjectName name of the project configuration file, found upward from the current directory
+const ProjectName = "i18n-stringer.toml"
+
+// Project configuration file, shared defaults of flags in table [defaults]
+// and jobs in array of tables [[job]], keys of both are flag names, such as
+//
+//	[defaults]
+//	defaultlocale = "en"
+//	ctxkey = "lang"
+//
+//	[[job]]
+//	dir = "internal/user"
+//	type = "UserCode,UserStatus"
+//	splitlocales = true
+//
+// dir of a job is the package directory or pattern like ./..., default the directory of the
+// configuration file. Paths of dir, tomlpath, output and allowlist are relative to the configuration file.
+type Project struct {
+	Path     string      // absolute configuration file path
+	Dir      string      // absolute directory of the configuration file
+	Defaults []Setting   // table [defaults]
+	Jobs     [][]Setting // array of tables [[job]]
+}
+
+// Setting one key value pair of the configuration file
+type Setting struct {
+	Key   string
+	Value string
+	Line  int // line in the configuration file, 0 when implied by the job
+}
+
+// ProjectJob one job of the configuration file resolved for a run
+type ProjectJob struct {
+	Settings []Setting // flags by the defaults and then the job, paths absolute
+	Pattern  string    // absolute package directory or pattern of dir
+	Args     []string  // flags by the defaults and the job as -key=value, a job overriding the defaults, for headers
+	Dir      string    // dir of the job as written, "." by default, for headers
+}
+
+// FindProject returns path when set, such as by the -config flag, else the path of the
+// configuration file found upward from the current directory, empty when there is none.
+func FindProject(path string) string {
+	if path != "" {
+		return path
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		path = filepath.Join(dir, ProjectName)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// ReadProject parses the configuration file at path, isFlag reports whether a key is a flag name,
+// problems are returned as *Error with their line.
+func ReadProject(path string, isFlag func(name string) bool) (*Project, error) {
+	path = absPath(path)
+	stream, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &Error{Pos: token.Position{Filename: path}, Msg: "read configuration file occur err " + err.Error()}
+	}
+	p := &Project{Path: path, Dir: filepath.Dir(path)}
+
+	var section *[]Setting
+	lines := strings.Split(string(stream), "\n")
+	for i := 0; i < len(lines); i++ {
+		var line = strings.Trim(lines[i], " \t\n\r")
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		pos := token.Position{Filename: path, Line: i + 1}
+		fail := func(format string, args ...interface{}) (*Project, error) {
+			return nil, &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+		}
+		switch line {
+		case "[defaults]":
+			section = &p.Defaults
+			continue
+		case "[[job]]":
+			p.Jobs = append(p.Jobs, nil)
+			section = &p.Jobs[len(p.Jobs)-1]
+			continue
+		}
+		if line[0] == '[' {
+			return fail("unknown table %s, only [defaults] and [[job]] supported", line)
+		}
+
+		idx := strings.Index(line, "=")
+		if idx < 0 || section == nil {
+			return fail("expect key = value in table [defaults] or [[job]]")
+		}
+		key := strings.Trim(line[0:idx], " \t")
+		value := strings.Trim(line[idx+1:], " \t")
+		if key == "config" || key != "dir" && !isFlag(key) {
+			return fail("unknown key `%s`, keys are flag names", key)
+		}
+		if len(value) > 0 && value[0] == '"' {
+			pValue, ok := UnquoteTOML(value)
+			if !ok {
+				return fail("value of key `%s` parse faild, backslash(\\) may be used incorrectly", key)
+			}
+			value = pValue
+		}
+		*section = append(*section, Setting{Key: key, Value: value, Line: i + 1})
+	}
+	return p, nil
+}
+
+// resolve returns path relative to the configuration file as absolute path
+func (p *Project) resolve(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(p.Dir, path)
+}
+
+// Settings returns the defaults and then the settings of job as flags to set in order, without dir and
+// keys in explicit, the flags set on the command line which take precedence. Paths are absolute.
+func (p *Project) Settings(job []Setting, explicit map[string]bool) []Setting {
+	var settings []Setting
+	for _, item := range append(append([]Setting{}, p.Defaults...), job...) {
+		if explicit[item.Key] || item.Key == "dir" {
+			continue
+		}
+		if item.Key == "tomlpath" || item.Key == "output" || item.Key == "allowlist" {
+			item.Value = p.resolve(item.Value)
+		}
+		settings = append(settings, item)
+	}
+	return settings
+}
+
+// Job returns job n of Jobs resolved for a run, explicit as of Settings. The TOML files of a job
+// whose dir is a single package directory default to its i18n directory, by a setting of line 0.
+func (p *Project) Job(n int, explicit map[string]bool) ProjectJob {
+	job := ProjectJob{Settings: p.Settings(p.Jobs[n], explicit), Dir: "."}
+	tomlpath := explicit["tomlpath"]
+	position := make(map[string]int) // a job overrides the defaults
+	for _, item := range append(append([]Setting{}, p.Defaults...), p.Jobs[n]...) {
+		switch {
+		case item.Key == "dir":
+			job.Dir = item.Value
+		case explicit[item.Key]:
+		case position[item.Key] > 0:
+			job.Args[position[item.Key]-1] = "-" + item.Key + "=" + item.Value
+		default:
+			job.Args = append(job.Args, "-"+item.Key+"="+item.Value)
+			position[item.Key] = len(job.Args)
+		}
+		tomlpath = tomlpath || item.Key == "tomlpath" && item.Value != ""
+	}
+	job.Pattern = p.resolve(job.Dir)
+	if strings.HasSuffix(job.Dir, "...") {
+		job.Pattern = p.resolve(strings.TrimSuffix(job.Dir, "...")) + string(filepath.Separator) + "..."
+	} else if !tomlpath {
+		job.Settings = append(job.Settings, Setting{Key: "tomlpath", Value: filepath.Join(job.Pattern, "i18n")})
+	}
+	return job
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// isFlag the flag names of the keys used by the tests
+func isFlag(name string) bool {
+	switch name {
+	case "type", "ctxkey", "tomlpath", "output", "mode":
+		return true
+	}
+	return false
+}
+
+// writeProject writes src as the configuration file of a temporary directory, returns its path
+func writeProject(t *testing.T, src string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), ProjectName)
+	if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestReadProject(t *testing.T) {
+	path := writeProject(t, `# shared
+[defaults]
+ctxkey = "lang"
+mode = "const"
+
+[[job]]
+dir = "internal/user"
+type = "UserCode"
+mode = "embed"
+
+[[job]]
+dir = "internal/order/..."
+type = "OrderCode"
+tomlpath = "lang"
+`)
+	p, err := ReadProject(path, isFlag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Defaults) != 2 || len(p.Jobs) != 2 {
+		t.Fatalf("%d defaults and %d jobs, want 2 and 2", len(p.Defaults), len(p.Jobs))
+	}
+
+	dir := filepath.Dir(path)
+	job := p.Job(0, map[string]bool{"ctxkey": true})
+	if want := filepath.Join(dir, "internal/user"); job.Pattern != want {
+		t.Errorf("pattern %s, want %s", job.Pattern, want)
+	}
+	if want := []string{"-mode=embed", "-type=UserCode"}; !reflect.DeepEqual(job.Args, want) || job.Dir != "internal/user" {
+		t.Errorf("args %v %s, want %v internal/user", job.Args, job.Dir, want)
+	}
+	want := []Setting{{"mode", "const", 4}, {"type", "UserCode", 8}, {"mode", "embed", 9},
+		{"tomlpath", filepath.Join(dir, "internal/user/i18n"), 0}}
+	if !reflect.DeepEqual(job.Settings, want) {
+		t.Errorf("settings %v, want %v", job.Settings, want)
+	}
+
+	job = p.Job(1, nil)
+	if want := filepath.Join(dir, "internal/order") + string(filepath.Separator) + "..."; job.Pattern != want {
+		t.Errorf("pattern %s, want %s", job.Pattern, want)
+	}
+	want = []Setting{{"ctxkey", "lang", 3}, {"mode", "const", 4}, {"type", "OrderCode", 13}, {"tomlpath", filepath.Join(dir, "lang"), 14}}
+	if !reflect.DeepEqual(job.Settings, want) {
+		t.Errorf("settings %v, want %v", job.Settings, want)
+	}
+}
+
+func TestReadProjectErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"[defaults]\n[profile]\n", ":2: unknown table [profile], only [defaults] and [[job]] supported"},
+		{"type = \"Code\"\n", ":1: expect key = value in table [defaults] or [[job]]"},
+		{"[[job]]\nbogus = 1\n", ":2: unknown key `bogus`, keys are flag names"},
+		{"[[job]]\nconfig = \"other.toml\"\n", ":2: unknown key `config`, keys are flag names"},
+		{"[[job]]\ntype = \"Code\\q\"\n", ":2: value of key `type` parse faild"},
+	}
+	for _, tc := range tests {
+		_, err := ReadProject(writeProject(t, tc.src), isFlag)
+		if err == nil || !strings.Contains(err.Error(), ProjectName+tc.want) {
+			t.Errorf("ReadProject(%q) error %v, want %s", tc.src, err, tc.want)
+		}
+	}
+}
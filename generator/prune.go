@@ -0,0 +1,100 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Prune returns the TOML files of pkgs with the key-value pairs removed that no constant uses, one
+// file per changed TOML file. A key is removed only when it is unused, not a known gap of the allowlist,
+// by every package of pkgs sharing the TOML directory, so that keys of the types of other packages are
+// kept. Config.Severities does not matter, unused keys are pruned even when their kind is SeverityOff.
+// All definitions of the key in the files of the locale are removed with the # TODO(translate) comment
+// right above them added by Fix, other lines are kept as they are.
+func Prune(pkgs []*Package) ([]File, error) {
+	parsers := make(map[string]*parser) // TOML directory to its parsed files
+	shared := make(map[string]int)      // number of packages sharing a TOML directory
+	unused := make(map[string]int)      // number of packages not using directory, locale and key
+	for _, p := range pkgs {
+		dir, err := filepath.Abs(p.g.parser.path)
+		if err != nil {
+			return nil, err
+		}
+		parsers[dir] = p.g.parser
+		shared[dir]++
+		for _, item := range p.unused() {
+			if !p.allowed(item) {
+				unused[dir+"\x00"+item.Locale+"\x00"+item.Key]++
+			}
+		}
+	}
+
+	dirs := make([]string, 0, len(parsers))
+	for dir := range parsers {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	var res []File
+	for _, dir := range dirs {
+		parser := parsers[dir]
+		for _, locale := range parser.locales {
+			files := append([]string{}, parser.files[locale]...)
+			sort.Strings(files)
+			for _, file := range files {
+				src, err := os.ReadFile(file)
+				if err != nil {
+					return nil, err
+				}
+				source, pruned := pruneLines(src, func(key string) bool {
+					return unused[dir+"\x00"+locale+"\x00"+key] == shared[dir]
+				})
+				if pruned {
+					res = append(res, File{Name: file, Source: source})
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// pruneLines returns src without the key-value pairs whose key is unused, and the # TODO(translate)
+// comment right above them, reports whether any is removed
+func pruneLines(src []byte, unused func(key string) bool) ([]byte, bool) {
+	lines := strings.SplitAfter(string(src), "\n")
+	keep := make([]bool, len(lines))
+	pruned := false
+	table := ""
+	for i, line := range lines {
+		keep[i] = true
+		line = strings.Trim(line, " \t\n\r")
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		if line[0] == '[' && line[len(line)-1] == ']' {
+			table = strings.Trim(line[1:len(line)-1], " \t")
+			continue
+		}
+		idx := strings.Index(line, "=")
+		if idx < 0 || table == bitmaskTable || !unused(strings.Trim(line[0:idx], " \t\n\r")) {
+			continue
+		}
+		keep[i], pruned = false, true
+		if i > 0 && strings.HasPrefix(strings.Trim(lines[i-1], " \t\n\r"), todoComment) {
+			keep[i-1] = false
+		}
+	}
+
+	var b strings.Builder
+	for i, line := range lines {
+		if keep[i] {
+			b.WriteString(line)
+		}
+	}
+	return []byte(b.String()), pruned
+}
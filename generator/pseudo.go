@@ -0,0 +1,91 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// pseudoExpand percentage of letters a pseudo translation grows by, the usual expansion of
+// translations from English, so that truncated layouts show up before translators are done
+const pseudoExpand = 40
+
+// accented letters of pseudo translations, each the same letter of pseudoPlain
+var (
+	pseudoPlain  = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	pseudoAccent = []rune("ÅƁÇĐÉƑĜĤÎĴĶĻṀÑÖÞǪŔŠŢÛṼŴẊÝŽåƀçðéƒĝĥîĵķļɱñöþǫŕšţûṽŵẋýž")
+)
+
+// addPseudo adds the pseudo locale of Config.Pseudo to the parsed TOML of g, its texts are the
+// default locale texts of the constants of typeItems as pseudoText, so that it is generated,
+// looked up by Trans and Lang and checked by -gentest as any other locale, but not by Check.
+func (g *Generator) addPseudo(locale string, typeItems []string) {
+	for _, item := range g.parser.locales {
+		if item == locale {
+			g.errs.add(token.Position{Filename: g.parser.path}, "The pseudo locale `%s` is already a locale of the TOML", locale)
+			return
+		}
+	}
+	texts := make(map[string]string)
+	for _, typeName := range typeItems {
+		for _, value := range g.values[typeName] {
+			texts[value.key] = pseudoText(g.text(value, g.defaultLocale))
+		}
+	}
+	if separator, ok := g.parser.separators[g.defaultLocale]; ok {
+		g.parser.separators[locale] = separator
+	}
+	g.parser.localesMap[locale] = texts
+	g.parser.locales = append(g.parser.locales, locale)
+	sort.Strings(g.parser.locales)
+	g.parser.pseudo = locale
+}
+
+// pseudoText returns text with its letters accented, padded by pseudoExpand percent of its
+// letters and wrapped in brackets, like [Šţåţûš ~~], fmt verbs are kept as they are.
+// Texts without brackets in the UI are hard-coded, texts cut before ] are truncated.
+func pseudoText(text string) string {
+	if text == "" {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("[")
+	letters := 0
+	for i := 0; i < len(text); {
+		if text[i] == '%' {
+			// the verb with its flags, width, precision and argument index
+			j := i + 1
+			for j < len(text) && strings.IndexByte("+-# 0123456789.*[]", text[j]) >= 0 {
+				j++
+			}
+			if j < len(text) {
+				_, size := utf8.DecodeRuneInString(text[j:])
+				j += size
+			}
+			b.WriteString(text[i:j])
+			i = j
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if idx := strings.IndexRune(pseudoPlain, r); idx >= 0 {
+			r = pseudoAccent[idx]
+		}
+		if !unicode.IsSpace(r) {
+			letters++
+		}
+		b.WriteRune(r)
+		i += size
+	}
+	if expand := (letters*pseudoExpand + 99) / 100; expand > 0 {
+		b.WriteString(" ")
+		b.WriteString(strings.Repeat("~", expand))
+	}
+	b.WriteString("]")
+	return b.String()
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// jsonReport findings of Check written by WriteJSON
+type jsonReport struct {
+	Findings []jsonFinding `json:"findings"`
+}
+
+// jsonFinding one Finding in JSON
+type jsonFinding struct {
+	Kind     Kind     `json:"kind"`
+	Severity Severity `json:"severity"`
+	Allowed  bool     `json:"allowed,omitempty"`
+	Package  string   `json:"package"`
+	Type     string   `json:"type,omitempty"`
+	Const    string   `json:"const,omitempty"`
+	Locale   string   `json:"locale,omitempty"`
+	Key      string   `json:"key"`
+	Note     string   `json:"note,omitempty"`
+	Message  string   `json:"message"`
+	File     string   `json:"file,omitempty"`
+	Line     int      `json:"line,omitempty"`
+	Column   int      `json:"column,omitempty"`
+}
+
+// WriteJSON writes findings of Check to w as a JSON object {"findings": [...]}, in the order given
+func WriteJSON(w io.Writer, findings []Finding) error {
+	report := jsonReport{Findings: make([]jsonFinding, 0, len(findings))}
+	for _, f := range findings {
+		report.Findings = append(report.Findings, jsonFinding{
+			Kind:     f.Kind,
+			Severity: f.Severity,
+			Allowed:  f.Allowed,
+			Package:  f.Package,
+			Type:     f.Type,
+			Const:    f.Const,
+			Locale:   f.Locale,
+			Key:      f.Key,
+			Note:     f.Note,
+			Message:  f.Message(),
+			File:     f.Pos.Filename,
+			Line:     f.Pos.Line,
+			Column:   f.Pos.Column,
+		})
+	}
+	return writeIndent(w, report)
+}
+
+// SARIF 2.1.0, only the properties used for annotations of code scanning
+type (
+	sarifLog struct {
+		Version string     `json:"version"`
+		Schema  string     `json:"$schema"`
+		Runs    []sarifRun `json:"runs"`
+	}
+	sarifRun struct {
+		Tool    sarifTool     `json:"tool"`
+		Results []sarifResult `json:"results"`
+	}
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+	sarifDriver struct {
+		Name           string      `json:"name"`
+		InformationURI string      `json:"informationUri"`
+		Rules          []sarifRule `json:"rules"`
+	}
+	sarifRule struct {
+		ID                   string             `json:"id"`
+		ShortDescription     sarifMessage       `json:"shortDescription"`
+		DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	}
+	sarifConfiguration struct {
+		Level string `json:"level"`
+	}
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+	sarifResult struct {
+		RuleID       string             `json:"ruleId"`
+		Level        string             `json:"level"`
+		Message      sarifMessage       `json:"message"`
+		Locations    []sarifLocation    `json:"locations,omitempty"`
+		Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+	}
+	sarifSuppression struct {
+		Kind          string `json:"kind"`
+		Justification string `json:"justification"`
+	}
+	sarifLocation struct {
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+	}
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           *sarifRegion          `json:"region,omitempty"`
+	}
+	sarifArtifactLocation struct {
+		URI       string `json:"uri"`
+		URIBaseID string `json:"uriBaseId,omitempty"`
+	}
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn,omitempty"`
+	}
+)
+
+// WriteSARIF writes findings of Check to w as a SARIF 2.1.0 log, relative file paths are
+// relative to %SRCROOT%, so that code scanning annotates them on pull requests. The level of
+// a result is the severity of the finding, known gaps of the allowlist are suppressed.
+func WriteSARIF(w io.Writer, findings []Finding) error {
+	driver := sarifDriver{Name: "i18n-stringer", InformationURI: "https://github.com/jjonline/i18n-stringer"}
+	for _, item := range kinds {
+		driver.Rules = append(driver.Rules, sarifRule{ID: string(item.kind), ShortDescription: sarifMessage{Text: item.text},
+			DefaultConfiguration: sarifConfiguration{Level: string(item.severity)}})
+	}
+	run := sarifRun{Tool: sarifTool{Driver: driver}, Results: make([]sarifResult, 0, len(findings))}
+	for _, f := range findings {
+		result := sarifResult{RuleID: string(f.Kind), Level: string(f.Severity), Message: sarifMessage{Text: f.Message()}}
+		if f.Allowed {
+			result.Suppressions = []sarifSuppression{{Kind: "external", Justification: "known gap of the allowlist"}}
+		}
+		if f.Pos.Filename != "" {
+			location := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(f.Pos.Filename)}}
+			if filepath.IsAbs(f.Pos.Filename) {
+				location.ArtifactLocation.URI = "file://" + ensurePrefix(location.ArtifactLocation.URI, "/")
+			} else {
+				location.ArtifactLocation.URIBaseID = "%SRCROOT%"
+			}
+			if f.Pos.Line > 0 {
+				location.Region = &sarifRegion{StartLine: f.Pos.Line, StartColumn: f.Pos.Column}
+			}
+			result.Locations = []sarifLocation{{PhysicalLocation: location}}
+		}
+		run.Results = append(run.Results, result)
+	}
+	return writeIndent(w, sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	})
+}
+
+// ensurePrefix returns s with prefix, such as the slash before the drive letter of a file URI on Windows
+func ensurePrefix(s, prefix string) string {
+	if strings.HasPrefix(s, prefix) {
+		return s
+	}
+	return prefix + s
+}
+
+// writeIndent writes v to w as indented JSON without HTML escaping
+func writeIndent(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
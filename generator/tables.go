@@ -0,0 +1,1145 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// buildFragment produces the IsFragment method, which Wrap and WrapWithContext use to reject fragments.
+func (g *Generator) buildFragment(runs [][]Value, typeName string) {
+	var names []string
+	for _, run := range runs {
+		for _, value := range run {
+			if value.fragment {
+				names = append(names, value.originalName)
+			}
+		}
+	}
+	g.Printf("\n")
+	g.Printf("// IsFragment report whether i is a placeholder fragment constant, which is only used as\n")
+	g.Printf("// replacement value of other translations and can not be wrapped as an error\n")
+	g.Printf("func (i %s) IsFragment() bool {\n", typeName)
+	if len(names) > 0 {
+		g.Printf("\tswitch i {\n")
+		g.Printf("\tcase %s:\n", strings.Join(names, ", "))
+		g.Printf("\t\treturn true\n")
+		g.Printf("\t}\n")
+	}
+	g.Printf("\treturn false\n")
+	g.Printf("}\n")
+}
+
+// defaultSeparator joins translations of set bits of a bitmask type when TOML has no separator for the locale
+const defaultSeparator = ", "
+
+// buildBitmask produces the _transBits method, which translates a defined value as it is and
+// a combined value as the translations of its single bit constants joined by the separator of the locale.
+func (g *Generator) buildBitmask(runs [][]Value, typeName string) {
+	var names, bits []string
+	for _, run := range runs {
+		for _, value := range run {
+			names = append(names, value.originalName)
+			if value.value != 0 && value.value&(value.value-1) == 0 {
+				bits = append(bits, value.originalName)
+			}
+		}
+	}
+	separators := make([]string, 0, len(g.parser.locales))
+	for _, locale := range g.parser.locales {
+		separator, ok := g.parser.separators[locale]
+		if !ok {
+			separator = defaultSeparator
+		}
+		separators = append(separators, fmt.Sprintf("%q: %q", locale, separator))
+	}
+	g.Printf("\n")
+	g.Printf(bitmaskTrans, typeName, strings.Join(names, ", "), strings.Join(bits, ", "),
+		strings.Join(separators, ", "), fallbackText(typeName, g.valueText(typeName, "rest")))
+}
+
+// Arguments to format are:
+//
+//	[1]: typeName
+//	[2]: names of all defined values
+//	[3]: names of single bit values in ascending order
+//	[4]: locale to separator map items
+//	[5]: fallback text of unknown bits
+const bitmaskTrans = `// _%[1]s_bits single bit constants of %[1]s in ascending order
+var _%[1]s_bits = []%[1]s{%[3]s}
+
+// _%[1]s_separators separator joining translations of set bits by locale, set by TOML table [bitmask]
+var _%[1]s_separators = map[string]string{%[4]s}
+
+// _transBits translate a defined value as it is, a combined value as translations of its set bits
+// joined by the separator of the locale, unknown bits are translated as fallback text
+func (i %[1]s) _transBits(li int) string {
+	switch i {
+	case %[2]s:
+		return i._transIdx(li)
+	}
+	var b strings.Builder
+	rest := i
+	for _, bit := range _%[1]s_bits {
+		if rest&bit == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(_%[1]s_separators[_%[1]s_locales[li]])
+		}
+		b.WriteString(bit._transIdx(li))
+		rest &^= bit
+	}
+	if rest != 0 || b.Len() == 0 {
+		if b.Len() > 0 {
+			b.WriteString(_%[1]s_separators[_%[1]s_locales[li]])
+		}
+		b.WriteString(%[5]s)
+	}
+	return b.String()
+}
+`
+
+// usize returns the number of bits of the smallest unsigned integer
+// type that will hold n. Used to create the smallest possible slice of
+// integers to use as indexes into the concatenated strings.
+func usize(n int) int {
+	switch {
+	case n < 1<<8:
+		return 8
+	case n < 1<<16:
+		return 16
+	default:
+		// 2^32 is enough constants for anyone.
+		return 32
+	}
+}
+
+// camelCase aa_bb to AaBb
+func camelCase(s string) string {
+	data := make([]byte, 0, len(s))
+	j := false
+	k := false
+	num := len(s) - 1
+	for i := 0; i <= num; i++ {
+		d := s[i]
+		if k == false && d >= 'A' && d <= 'Z' {
+			k = true
+		}
+		if d >= 'a' && d <= 'z' && (j || k == false) {
+			d = d - 32
+			j = false
+			k = true
+		}
+		if k && (d == '_' || d == '-') && num > i && s[i+1] >= 'a' && s[i+1] <= 'z' {
+			j = true
+			continue
+		}
+		data = append(data, d)
+	}
+	return string(data[:])
+}
+
+// text returns the translation of value in locale, the line comment of value is the default
+// locale text when -linecomment is set and TOML has no value.
+func (g *Generator) text(value Value, locale string) string {
+	if value.comment != "" && locale == g.defaultLocale && !g.parser.HasLocaleValue(value.key, locale) {
+		return value.comment
+	}
+	return g.parser.GetLocaleValue(value.key, locale)
+}
+
+// tableName returns the name of a generated table of the type,
+// tables of split files are suffixed with their locale so that they do not collide.
+func (g *Generator) tableName(typeName, table, suffix string) string {
+	if g.splitFile {
+		return fmt.Sprintf("_%s_%s_%s%s", typeName, camelCase(g.locales[0]), table, suffix)
+	}
+	return fmt.Sprintf("_%s_%s%s", typeName, table, suffix)
+}
+
+// textAt returns the expression of the text at position pos of the run table with suffix,
+// for locale index li of generated method.
+func (g *Generator) textAt(typeName, suffix, pos string) string {
+	if g.pool != nil {
+		return fmt.Sprintf("%sText(int(%s[%s][%s]))", g.pool.name, g.tableName(typeName, "ids", suffix), g.localeIdx(), pos)
+	}
+	name, index := g.tableName(typeName, "name", suffix), g.tableName(typeName, "index", suffix)
+	return fmt.Sprintf("%s[%s][%s[%s][%s]:%s[%s][%s+1]]", name, g.localeIdx(), index, g.localeIdx(), pos, index, g.localeIdx(), pos)
+}
+
+// localeIdx returns the expression of table index for locale index li of generated method,
+// tables of split files have only one locale.
+func (g *Generator) localeIdx() string {
+	if g.splitFile {
+		return "0"
+	}
+	return "li"
+}
+
+// fallbackText returns the expression of the text for value v without translation
+func fallbackText(typeName, text string) string {
+	return fmt.Sprintf("\"%[1]s[\" + _%[1]s_locales[li] + \"](\" + %[2]s + \")\"", typeName, text)
+}
+
+// valueText returns the expression formatting the value expression v of type as text,
+// the string itself for string types or the decimal form for integer types.
+func (g *Generator) valueText(typeName, v string) string {
+	if g.basicType[typeName] == "string" {
+		return fmt.Sprintf("string(%s)", v)
+	}
+	return fmt.Sprintf("strconv.FormatInt(int64(%s), 10)", v)
+}
+
+// declareIndexAndNameVars declares the index slices and concatenated names
+// strings representing the runs of values.
+func (g *Generator) declareIndexAndNameVars(runs [][]Value, typeName string) {
+	var decls []string
+	for i, run := range runs {
+		decls = append(decls, g.createIndexAndNameDecl(run, typeName, fmt.Sprintf("_%d", i), len(run) == 1)...)
+	}
+	g.Printf("var (\n")
+	for _, decl := range decls {
+		g.Printf("\t%s\n", decl)
+	}
+	g.Printf(")\n\n")
+}
+
+// declareIndexAndNameVar is the single-run version of declareIndexAndNameVars
+func (g *Generator) declareIndexAndNameVar(run []Value, typeName string) {
+	g.Printf("var (\n")
+	for _, decl := range g.createIndexAndNameDecl(run, typeName, "", false) {
+		g.Printf("\t%s\n", decl)
+	}
+	g.Printf(")\n\n")
+}
+
+// createIndexAndNameDecl returns the declarations of tables for the run indexed by locale first.
+// The caller will add "var". Run of single value does not need the index table.
+// Shared pool ids take the place of both names and indexes when the pool is used.
+func (g *Generator) createIndexAndNameDecl(run []Value, typeName string, suffix string, single bool) []string {
+	if g.pool != nil {
+		b := new(bytes.Buffer)
+		if single {
+			_, _ = fmt.Fprintf(b, "%s = [...]uint%d{", g.tableName(typeName, "ids", suffix), usize(len(g.pool.texts)))
+		} else {
+			_, _ = fmt.Fprintf(b, "%s = [...][%d]uint%d{", g.tableName(typeName, "ids", suffix), len(run), usize(len(g.pool.texts)))
+		}
+		for n, locale := range g.locales {
+			ids := make([]int, len(run))
+			for i := range run {
+				ids[i] = g.pool.add(g.text(run[i], locale))
+			}
+			if n > 0 {
+				_, _ = fmt.Fprintf(b, ", ")
+			}
+			if single {
+				_, _ = fmt.Fprintf(b, "%d", ids[0])
+				continue
+			}
+			_, _ = fmt.Fprintf(b, "{%s}", joinInts(ids))
+		}
+		_, _ = fmt.Fprintf(b, "}")
+		return []string{b.String()}
+	}
+
+	names := make([]string, len(g.locales))
+	indexes := make([][]int, len(g.locales))
+	maxLen := 0
+	for n, locale := range g.locales {
+		b := new(bytes.Buffer)
+		indexes[n] = append(make([]int, 0, len(run)+1), 0)
+		for i := range run {
+			b.WriteString(g.text(run[i], locale))
+			indexes[n] = append(indexes[n], b.Len())
+		}
+		names[n] = fmt.Sprintf("%q", b.String())
+		if b.Len() > maxLen {
+			maxLen = b.Len()
+		}
+	}
+	nameDecl := fmt.Sprintf("%s = [...]string{%s}", g.tableName(typeName, "name", suffix), strings.Join(names, ", "))
+	if single {
+		return []string{nameDecl}
+	}
+
+	b := new(bytes.Buffer)
+	_, _ = fmt.Fprintf(b, "%s = [...][%d]uint%d{", g.tableName(typeName, "index", suffix), len(run)+1, usize(maxLen))
+	for n := range indexes {
+		if n > 0 {
+			_, _ = fmt.Fprintf(b, ", ")
+		}
+		_, _ = fmt.Fprintf(b, "{%s}", joinInts(indexes[n]))
+	}
+	_, _ = fmt.Fprintf(b, "}")
+	return []string{nameDecl, b.String()}
+}
+
+// buildOneRun generates the variables and String method for a single run of contiguous values.
+func (g *Generator) buildOneRun(runs [][]Value, typeName string) {
+	values := runs[0]
+	g.Printf("\n")
+
+	// declare var
+	g.declareIndexAndNameVar(values, typeName)
+
+	// The generated code is simple enough to write as a Printf format.
+	lessThanZero := ""
+	if values[0].signed {
+		lessThanZero = "i < 0 || "
+	}
+
+	upper := fmt.Sprintf("len(%s[0])-1", g.tableName(typeName, "index", ""))
+	if g.pool != nil {
+		upper = fmt.Sprintf("len(%s[0])", g.tableName(typeName, "ids", ""))
+	}
+	text := g.textAt(typeName, "", "i")
+	if values[0].value == 0 { // Signed or unsigned, 0 is still 0.
+		g.Printf(i18nOneStringRun, typeName, upper, lessThanZero, text, g.transFunc, fallbackText(typeName, g.valueText(typeName, "i")))
+	} else {
+		fallback := fallbackText(typeName, g.valueText(typeName, "i+"+values[0].String()))
+		g.Printf(i18nOneRunWithOffset, typeName, values[0].String(), upper, lessThanZero, text, g.transFunc, fallback)
+	}
+}
+
+// Arguments to format are:
+//	[1]: type name
+//	[2]: number of values
+//	[3]: less than zero check (for signed types)
+//	[4]: text expression
+//	[5]: translate one CONST method name
+//	[6]: fallback text expression
+const i18nOneStringRun = `// %[5]s translate one CONST with locale index
+func (i %[1]s) %[5]s(li int) string {
+	if %[3]si >= %[1]s(%[2]s) {
+		return %[6]s
+	}
+	return %[4]s
+}
+`
+
+// Arguments to format are:
+//	[1]: type name
+//	[2]: lowest defined value for type, as a string
+//	[3]: number of values
+//	[4]: less than zero check (for signed types)
+//	[5]: text expression
+//	[6]: translate one CONST method name
+//	[7]: fallback text expression
+const i18nOneRunWithOffset = `// %[6]s translate one CONST with locale index
+func (i %[1]s) %[6]s(li int) string {
+	i -= %[2]s
+	if %[4]si >= %[1]s(%[3]s) {
+		return %[7]s
+	}
+	return %[5]s
+}
+`
+
+// buildMultipleRuns generates the variables and String method for multiple runs of contiguous values.
+// For this pattern, a single Printf format won't do.
+func (g *Generator) buildMultipleRuns(runs [][]Value, typeName string) {
+	g.Printf("\n")
+	g.declareIndexAndNameVars(runs, typeName)
+	g.Printf("// %s translate one CONST with locale index\n", g.transFunc)
+	g.Printf("func (i %s) %s(li int) string {\n", typeName, g.transFunc)
+	g.Printf("\tswitch {\n")
+	for i, values := range runs {
+		suffix := fmt.Sprintf("_%d", i)
+		if len(values) == 1 {
+			g.Printf("\tcase i == %s:\n", &values[0])
+			if g.pool != nil {
+				g.Printf("\t\treturn %sText(int(%s[%s]))\n", g.pool.name, g.tableName(typeName, "ids", suffix), g.localeIdx())
+				continue
+			}
+			g.Printf("\t\treturn %s[%s]\n", g.tableName(typeName, "name", suffix), g.localeIdx())
+			continue
+		}
+		if values[0].value == 0 && !values[0].signed {
+			// For an unsigned lower bound of 0, "0 <= i" would be redundant.
+			g.Printf("\tcase i <= %s:\n", &values[len(values)-1])
+		} else {
+			g.Printf("\tcase %s <= i && i <= %s:\n", &values[0], &values[len(values)-1])
+		}
+		if values[0].value != 0 {
+			g.Printf("\t\ti -= %s\n", &values[0])
+		}
+		g.Printf("\t\treturn %s\n", g.textAt(typeName, suffix, "i"))
+	}
+	g.Printf("\tdefault:\n")
+	g.Printf("\t\treturn %s\n", fallbackText(typeName, g.valueText(typeName, "i")))
+	g.Printf("\t}\n")
+	g.Printf("}\n")
+}
+
+// buildMap handles the case where the space is so sparse a map is a reasonable fallback.
+// It's a rare situation but has simple code.
+// The map only records position of each value in the tables shared by all locales.
+func (g *Generator) buildMap(runs [][]Value, typeName string) {
+	var values []Value
+	for _, run := range runs {
+		values = append(values, run...)
+	}
+	g.Printf("\n")
+	g.Printf("var (\n")
+	for _, decl := range g.createIndexAndNameDecl(values, typeName, "", false) {
+		g.Printf("\t%s\n", decl)
+	}
+	g.Printf("\t%s = map[%s]uint%d{\n", g.tableName(typeName, "map", ""), typeName, usize(len(values)))
+	for n, value := range values {
+		if value.isString() {
+			g.Printf("\t\t%s: %d,\n", value.originalName, n)
+			continue
+		}
+		g.Printf("\t\t%s: %d,\n", &value, n)
+	}
+	g.Printf("\t}\n")
+	g.Printf(")\n\n")
+	g.Printf(stringMap, typeName, g.tableName(typeName, "map", ""), g.textAt(typeName, "", "n"), g.transFunc, fallbackText(typeName, g.valueText(typeName, "i")))
+}
+
+// Arguments to format are:
+//	[1]: type name
+//	[2]: map name
+//	[3]: text expression
+//	[4]: translate one CONST method name
+//	[5]: fallback text expression
+const stringMap = `// %[4]s translate one CONST with locale index
+func (i %[1]s) %[4]s(li int) string {
+	if n, ok := %[2]s[i]; ok {
+		return %[3]s
+	}
+	return %[5]s
+}
+`
+
+// buildEmbed handles the embed mode, translations are recorded into the catalog asset
+// and looked up lazily from the embedded copy, no name or index table is generated.
+func (g *Generator) buildEmbed(runs [][]Value, typeName string) {
+	item := catalogType{
+		Values: make([]json.RawMessage, 0),
+		Texts:  make([][]string, len(g.locales)),
+	}
+	for _, run := range runs {
+		for _, value := range run {
+			item.Values = append(item.Values, value.json())
+			if value.fragment {
+				item.Fragments = append(item.Fragments, value.json())
+			}
+		}
+	}
+	for idx, locale := range g.locales {
+		item.Texts[idx] = make([]string, 0, len(item.Values))
+		for _, run := range runs {
+			for _, value := range run {
+				item.Texts[idx] = append(item.Texts[idx], g.text(value, locale))
+			}
+		}
+	}
+	g.catalog[typeName] = item
+
+	g.Printf("\n")
+	g.Printf(embedLookup, typeName, g.assetOwner, fallbackText(typeName, g.valueText(typeName, "i")))
+}
+
+// catalogAsset returns the JSON encoded catalog asset for embed mode
+func (g *Generator) catalogAsset() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(g.catalog); err != nil {
+		return nil, fmt.Errorf("encoding catalog asset: %s", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// catalogType translations of one type in the catalog asset
+type catalogType struct {
+	Values    []json.RawMessage `json:"values"`              // sorted CONST values
+	Texts     [][]string        `json:"texts"`               // texts[locale index][value index]
+	Fragments []json.RawMessage `json:"fragments,omitempty"` // sorted CONST values of placeholder fragments
+}
+
+// Arguments to format are:
+//	[1]: type name the asset named after
+//	[2]: asset file name
+const embedAsset = `
+// _%[1]s_catalogAsset translations catalog of all types in this file
+// generated by i18n-stringer flag -mode embed, Don't edit the asset file directly
+//go:embed %[2]s
+var _%[1]s_catalogAsset []byte
+`
+
+// Arguments to format are:
+//	[1]: type name
+//	[2]: type name the asset named after
+//	[3]: fallback text expression
+const embedLookup = `// _%[1]s_catalog translations of type %[1]s decoded lazily from the catalog asset
+var (
+	_%[1]s_catalogOnce sync.Once
+	_%[1]s_catalog     struct {
+		Values []%[1]s    ` + "`json:\"values\"`" + ` // sorted CONST values
+		Texts  [][]string ` + "`json:\"texts\"`" + `  // texts[locale index][value index]
+	}
+)
+
+// _%[1]s_catalogLoad decode type %[1]s translations from the catalog asset
+func _%[1]s_catalogLoad() {
+	var assets map[string]json.RawMessage
+	if err := json.Unmarshal(_%[2]s_catalogAsset, &assets); err != nil {
+		panic("i18n-stringer: invalid catalog asset: " + err.Error())
+	}
+	if err := json.Unmarshal(assets["%[1]s"], &_%[1]s_catalog); err != nil {
+		panic("i18n-stringer: invalid catalog asset of type %[1]s: " + err.Error())
+	}
+}
+
+// _transIdx translate one CONST with locale index
+func (i %[1]s) _transIdx(li int) string {
+	_%[1]s_catalogOnce.Do(_%[1]s_catalogLoad)
+
+	// binary search in sorted values
+	values := _%[1]s_catalog.Values
+	lo, hi := 0, len(values)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if values[mid] < i {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo == len(values) || values[lo] != i {
+		return %[3]s
+	}
+	return _%[1]s_catalog.Texts[li][lo]
+}
+`
+
+// buildLocaleSet build locale support mark map
+func (g *Generator) buildLocaleSet(typeName string) {
+	g.Printf("\n")
+	temp := new(bytes.Buffer)
+	names := make([]string, len(g.locales))
+	for idx, locale := range g.locales {
+		temp.WriteString(fmt.Sprintf("\"%s\": %d, ", locale, idx))
+		names[idx] = strconv.Quote(locale)
+	}
+	g.Printf(i18nLocaleSet, typeName, temp.String(), strings.Join(names, ", "))
+	g.Printf("\n\n")
+}
+
+// locale support mark
+// 1% typeName
+// 2% map k/v: "en": 0, "zh-hk": 1
+// 3% locales: "en", "zh-hk"
+const i18nLocaleSet = `// _%[1]s_locales All supported locales indexed by value of _%[1]s_supported
+var _%[1]s_locales = []string{%[3]s}
+
+// _%[1]s_supported All supported locales record, locale to index of _%[1]s_locales
+var _%[1]s_supported = map[string]int{%[2]s}`
+
+// buildCommFunc build common function
+func (g *Generator) buildCommFunc(typeName string) {
+	g.Printf("\n")
+	// Locales are resolved by a switch over their names, faster than hashing them for the map,
+	// which only holds the locales of split files registered at init time in addition.
+	var lookup strings.Builder
+	lookup.WriteString("\tswitch locale {\n")
+	for idx, locale := range g.locales {
+		_, _ = fmt.Fprintf(&lookup, "\tcase %q:\n\t\treturn %d\n", locale, idx)
+	}
+	lookup.WriteString("\t}\n")
+	if len(g.localeFiles) > 0 {
+		_, _ = fmt.Fprintf(&lookup, "\tif li, ok := _%s_supported[locale]; ok {\n\t\treturn li\n\t}\n", typeName)
+	}
+	g.Printf(commFunc, typeName, g.defaultLocale, g.ctxKey, camelCase(typeName), "%s", g.basicType[typeName], g.valueText(typeName, "i"),
+		lookup.String())
+	g.Printf("\n\n")
+}
+
+// Argument to format is the type name.
+// 1% typeName
+// 2% default locale
+// 3% default context get locale key name
+// 4% typeName for Capitalize the first letter
+// 5% just %s itself
+// 6% typ original TYPE name
+// 7% value of i as text
+// 8% switch resolving supported locale names to indexes
+const commFunc = `// _%[1]s_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _%[1]s_defaultLocale = "%[2]s"
+
+// _%[1]s_defaultIdx index of default locale in _%[1]s_locales
+var _%[1]s_defaultIdx = _%[1]s_supported[_%[1]s_defaultLocale]
+
+// _%[1]s_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _%[1]s_ctxKey = "%[3]s"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//  - You should not use this method in an internationalized language environment, as well as method Error.
+//  - Because this method always returns the translation value of the default language.
+//  - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//  - If you understand the above mechanism then you can use this method with confidence
+func (i %[1]s) String() string {
+	return i._trans(_%[1]s_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//  - You should not use this method in an internationalized language environment, as well as method String.
+//  - Because this method always returns the translation value of the default language.
+//  - This method implements the error interface, so that you can return the value as an error,
+//  - If you understand the above mechanism then you can use this method with confidence
+func (i %[1]s) Error() string {
+	return i._trans(_%[1]s_defaultIdx)
+}
+
+// Code get original type %[6]s value
+func (i %[1]s) Code() %[6]s {
+	return %[6]s(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//  - err another error
+//  - locale i18n locale name
+//  - args optional formatting component
+func (i %[1]s) Wrap(err error, locale string, args ...interface{}) *I18n%[4]sErrorWrap {
+	i._mustNotFragment()
+	return &I18n%[4]sErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//  - ctx context with Value use Key from _%[1]s_ctxKey, which pass by i18n-stringer flag -ctxkey
+//  - err another error
+//  - args optional formatting component
+func (i %[1]s) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18n%[4]sErrorWrap {
+	i._mustNotFragment()
+	return &I18n%[4]sErrorWrap{err: err, origin: i, locale: _%[1]s_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i %[1]s) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("%[1]s(" + %[7]s + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18n%[4]sErrorWrap type i18n error wrapper
+//   WARNING
+//   This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//   Pass easily obtain internationalized translations through Error, String, Translate
+//   WARNING
+type I18n%[4]sErrorWrap struct {
+	err    error         // wrap another error
+	origin %[1]s         // custom shaping type Val
+	locale string  	     // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18n%[4]sErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//  - locale specified language locale identifier
+//  - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18n%[4]sErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18n%[4]sErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//  - this method will be formatted wrap error if exist.
+//  - Only for development and debugging, or logging full error message
+//  - if you want to get typed message, please use method String or Translate
+func (e *I18n%[4]sErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%[5]s (%[5]s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//  - this method will be formatted wrap error if exist.
+//  - Only for development and debugging, or logging full error message
+//  - if you want to get typed message, please use method String or Translate
+func (e *I18n%[4]sErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18n%[4]sErrorWrap) Value() %[1]s {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18n%[4]sErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i %[1]s) IsLocaleSupport(locale string) bool {
+	return _%[1]s_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//  - ctx  context with Value use Key from _%[1]s_ctxKey, which pass by i18n-stringer flag -ctxkey
+//  - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i %[1]s) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_%[1]s_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//  - locale specified language locale identifier, need pass by IsLocaleSupport
+//  - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i %[1]s) Trans(locale string, args ...interface{}) string {
+	return i._trans(_%[1]s_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//  - locale specified language locale identifier
+//  - returns -1 when the locale is not supported
+func (i %[1]s) LocaleIndex(locale string) int {
+	return _%[1]s_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//  - li   language locale index, default locale used when invalid
+//  - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i %[1]s) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_%[1]s_locales) {
+		li = _%[1]s_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _%[1]s_isLocaleSupport(locale string) bool {
+	return _%[1]s_lookupLocale(locale) >= 0
+}
+
+// _%[1]s_lookupLocale resolve language locale name to index of _%[1]s_locales, -1 when it is not supported
+func _%[1]s_lookupLocale(locale string) int {
+%[8]s	return -1
+}
+
+// _%[1]s_localeIdx resolve language locale name to index of _%[1]s_locales.
+// It returns index of default locale when _%[1]s_isLocaleSupport is false
+func _%[1]s_localeIdx(locale string) int {
+	if li := _%[1]s_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _%[1]s_defaultIdx
+}
+
+// _%[1]s_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _%[1]s_locales.
+// It returns index of default locale when _%[1]s_isLocaleSupport is false
+func _%[1]s_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _%[1]s_defaultIdx
+	}
+	if v, ok := ctx.Value(_%[1]s_ctxKey).(string); ok {
+		return _%[1]s_localeIdx(v)
+	}
+	return _%[1]s_defaultIdx
+}
+
+// _%[1]s_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _%[1]s_isLocaleSupport is false
+func _%[1]s_localeFromCtxWithFallback(ctx context.Context) string {
+	return _%[1]s_locales[_%[1]s_localeIdxFromCtx(ctx)]
+}`
+
+// buildI18nTransFunc build common function
+func (g *Generator) buildI18nTransFunc(runs [][]Value, typeName string) {
+	// Translations with fmt verbs are precompiled into segments here, so that neither
+	// the verbs nor the args of translations without them are looked at at runtime.
+	fmtValues := g.fmtValues(runs)
+	// Combined values of bitmask types are translated bit by bit and formatted by fmt.
+	transFunc, other := "_transIdx", "nil"
+	if g.bitmask {
+		transFunc = "_transBits"
+		if len(fmtValues) > 0 {
+			other = "_" + typeName + "_fmtAny"
+			g.Printf("\n// %s combined values of bit flags formatted by fmt.Sprintf\n", other)
+			g.Printf("var %s = []_%s_seg{{verb: '!'}}\n", other, typeName)
+		}
+	}
+	var body strings.Builder
+	if len(fmtValues) > 0 {
+		body.WriteString("\tswitch i {\n")
+		for k, value := range fmtValues {
+			_, _ = fmt.Fprintf(&body, "\tcase %s:\n\t\treturn _%s_fmt[li][%d]\n", value.originalName, typeName, k)
+		}
+		if other != "nil" {
+			formatted := make(map[string]bool, len(fmtValues))
+			for _, value := range fmtValues {
+				formatted[value.originalName] = true
+			}
+			var names []string
+			for _, run := range runs {
+				for _, value := range run {
+					if !formatted[value.originalName] {
+						names = append(names, value.originalName)
+					}
+				}
+			}
+			if len(names) > 0 {
+				_, _ = fmt.Fprintf(&body, "\tcase %s:\n\t\treturn nil\n", strings.Join(names, ", "))
+			}
+		}
+		body.WriteString("\t}\n")
+	}
+	g.Printf("\n")
+	// Only generated with the debug mode, so that translations never check it otherwise.
+	showKey := func(result string) string {
+		if !g.showKeys {
+			return ""
+		}
+		return fmt.Sprintf("\tif atomic.LoadInt32(&_%s_showKeys) != 0 {\n\t\treturn %s\n\t}\n", typeName, result)
+	}
+	g.Printf(i18nTransFun, typeName, len(fmtValues) > 0, transFunc, g.fmtTable(fmtValues, typeName), body.String(), other,
+		showKey("i._showKey(li, args)"), showKey("append(dst, i._showKey(li, args)...)"), showKey("io.WriteString(w, i._showKey(li, args))"))
+	g.Printf("\n\n")
+}
+
+// fmtValues returns the values of runs whose translation has a fmt verb in any locale, a value
+// at position k of them has its segments at _T_fmt[li][k]
+func (g *Generator) fmtValues(runs [][]Value) []Value {
+	var values []Value
+	for _, run := range runs {
+		for _, value := range run {
+			for _, locale := range g.parser.locales {
+				if segments(g.text(value, locale)) != nil {
+					values = append(values, value)
+					break
+				}
+			}
+		}
+	}
+	return values
+}
+
+// fmtTable returns the expression of the segments of values in the locales of g, indexed by locale index,
+// then by position of values
+func (g *Generator) fmtTable(values []Value, typeName string) string {
+	b := new(bytes.Buffer)
+	_, _ = fmt.Fprintf(b, "[][][]_%s_seg{\n", typeName)
+	for _, locale := range g.locales {
+		items := make([]string, len(values))
+		for k, value := range values {
+			items[k] = segmentsText(segments(g.text(value, locale)))
+		}
+		_, _ = fmt.Fprintf(b, "\t{%s}, // %s\n", strings.Join(items, ", "), locale)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// segment one part of a translation with fmt verbs precompiled for the generated _T_appendf,
+// the literal text[lo:hi] followed by verb
+type segment struct {
+	lo, hi int
+	verb   byte // 's', 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none, '!' all by fmt.Sprintf
+}
+
+// segments returns the segments of text, nil when it has no fmt verb. Verbs other than %s %v %d,
+// flags, widths, explicit argument indexes and texts too long for uint16 offsets are left to
+// fmt.Sprintf, by a single segment of verb '!'.
+func segments(text string) []segment {
+	if strings.IndexByte(text, '%') < 0 {
+		return nil
+	}
+	if len(text) > math.MaxUint16 {
+		return []segment{{verb: '!'}}
+	}
+	var segs []segment
+	lo := 0
+	for k := 0; k < len(text); k++ {
+		if text[k] != '%' {
+			continue
+		}
+		if k+1 == len(text) {
+			return []segment{{verb: '!'}}
+		}
+		switch verb := text[k+1]; verb {
+		case 's', 'v', 'd', '%':
+			segs = append(segs, segment{lo: lo, hi: k, verb: verb})
+		default:
+			return []segment{{verb: '!'}}
+		}
+		k++
+		lo = k + 1
+	}
+	if lo < len(text) {
+		segs = append(segs, segment{lo: lo, hi: len(text)})
+	}
+	return segs
+}
+
+// segmentsText returns the composite literal of segs, nil when empty
+func segmentsText(segs []segment) string {
+	if segs == nil {
+		return "nil"
+	}
+	items := make([]string, len(segs))
+	for n, seg := range segs {
+		verb := "0"
+		if seg.verb != 0 {
+			verb = strconv.QuoteRune(rune(seg.verb))
+		}
+		items[n] = fmt.Sprintf("{%d, %d, %s}", seg.lo, seg.hi, verb)
+	}
+	return "{" + strings.Join(items, ", ") + "}"
+}
+
+// Arguments to format are:
+//
+//	[1]: typeName
+//	[2]: whether any translation of the type has a fmt verb
+//	[3]: name of the method translating one value
+//	[4]: segments of the translations with fmt verbs
+//	[5]: switch over the constants with fmt verbs
+//	[6]: segments of any other value
+//	[7]: check of the debug mode of _trans, empty without it
+//	[8]: check of the debug mode of AppendTrans
+//	[9]: check of the debug mode of WriteTrans
+const i18nTransFun = `// _%[1]s_hasVerbs whether any translation of %[1]s has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _%[1]s_hasVerbs = %[2]t
+
+// _%[1]s_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _%[1]s_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _%[1]s_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _%[1]s_fmt = %[4]s
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i %[1]s) _segs(li int) []_%[1]s_seg {
+%[5]s	return %[6]s
+}
+
+// _%[1]s_bufPool buffers formatting translations with args
+var _%[1]s_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _%[1]s_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i %[1]s) _trans(li int, args ...interface{}) string {
+%[7]s	return i._format(i.%[3]s(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i %[1]s) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_%[1]s_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _%[1]s_bufPool.Get().(*[]byte)
+	*buf = _%[1]s_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_%[1]s_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//  - dst    buffer to append to
+//  - locale specified language locale identifier, default locale used when not supported
+//  - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i %[1]s) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _%[1]s_localeIdx(locale)
+%[8]s	msg := i.%[3]s(li)
+	if len(args) == 0 || !_%[1]s_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _%[1]s_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//  - w      writer to write to
+//  - locale specified language locale identifier, default locale used when not supported
+//  - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i %[1]s) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _%[1]s_localeIdx(locale)
+%[9]s	msg := i.%[3]s(li)
+	if len(args) == 0 || !_%[1]s_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _%[1]s_bufPool.Get().(*[]byte)
+	*buf = _%[1]s_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_%[1]s_bufPool.Put(buf)
+	return n, err
+}
+
+// _%[1]s_sprintf format msg with args, args of type %[1]s translated use locale index li
+func _%[1]s_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _%[1]s_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _%[1]s_transArg translate arg use locale index li when arg is a value of %[1]s, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _%[1]s_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case %[1]s:
+		return typ._trans(li)
+	case interface{ Trans(locale string, args ...interface{}) string }:
+		return typ.Trans(_%[1]s_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _%[1]s_appendf append msg formatted with args by its segments segs to dst, the verbs %%s %%v of string or
+// generated types and %%d %%v of int are formatted in place, anything else falls back to _%[1]s_sprintf
+func _%[1]s_appendf(dst []byte, msg string, segs []_%[1]s_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%%':
+			dst = append(dst, '%%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case %[1]s:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface{ Trans(locale string, args ...interface{}) string }:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_%[1]s_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _%[1]s_sprintf(msg, li, args)...)
+}`
+
+// buildShowKeys build the debug mode showing the constant which produced each message, only by
+// Config.ShowKeys, translations of code generated without it never check the mode
+func (g *Generator) buildShowKeys(runs [][]Value, typeName string) {
+	var cases strings.Builder
+	for _, run := range runs {
+		for _, value := range run {
+			_, _ = fmt.Fprintf(&cases, "\tcase %s:\n\t\treturn %q\n", value.originalName, "."+value.originalName)
+		}
+	}
+	transFunc := "_transIdx"
+	if g.bitmask {
+		transFunc = "_transBits"
+	}
+	g.Printf("\n")
+	g.Printf(showKeys, typeName, camelCase(typeName), cases.String(), g.valueText(typeName, "i"), transFunc)
+	g.Printf("\n")
+}
+
+// Arguments to format are:
+//
+//	[1]: typeName
+//	[2]: typeName for Capitalize the first letter
+//	[3]: cases of the constant names
+//	[4]: value of i as text
+//	[5]: name of the method translating one value
+const showKeys = `// _%[1]s_showKeys show keys mode of %[1]s, 0 off, 1 key or 2 annotate, set by the
+// environment variable I18N_STRINGER_SHOW_KEYS at start and SetI18n%[2]sShowKeys later
+var _%[1]s_showKeys = _%[1]s_showKeysMode(os.Getenv("I18N_STRINGER_SHOW_KEYS"))
+
+// SetI18n%[2]sShowKeys set the debug mode showing which constant of %[1]s produced each message,
+// such as for UI reviews, the same as environment variable I18N_STRINGER_SHOW_KEYS
+//  - key      Trans, Lang, String, Error and the others return %[1]s.ConstName(code) instead of translations
+//  - annotate translations are followed by {%[1]s.ConstName(code)}
+//  - any other mode, such as empty, turns it off, the default
+func SetI18n%[2]sShowKeys(mode string) {
+	atomic.StoreInt32(&_%[1]s_showKeys, _%[1]s_showKeysMode(mode))
+}
+
+// _%[1]s_showKeysMode resolve show keys mode name to the value of _%[1]s_showKeys
+func _%[1]s_showKeysMode(mode string) int32 {
+	switch mode {
+	case "key":
+		return 1
+	case "annotate":
+		return 2
+	}
+	return 0
+}
+
+// _showKey returns %[1]s.ConstName(code) of i, following the translation formatted with args when annotated
+func (i %[1]s) _showKey(li int, args []interface{}) string {
+	key := "%[1]s" + i._constName() + "(" + %[4]s + ")"
+	if atomic.LoadInt32(&_%[1]s_showKeys) == 1 {
+		return key
+	}
+	return i._format(i.%[5]s(li), li, args) + " {" + key + "}"
+}
+
+// _constName returns the constant name of i prefixed by a dot, empty when i is not a constant
+func (i %[1]s) _constName() string {
+	switch i {
+%[3]s	}
+	return ""
+}`
@@ -0,0 +1,27 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import "testing"
+
+func TestSegments(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"plain text", "nil"},
+		{"hello %s", "{{0, 6, 's'}}"},
+		{"%d items, %v%% done", "{{0, 0, 'd'}, {2, 10, 'v'}, {12, 12, '%'}, {14, 19, 0}}"},
+		{"width %5d", "{{0, 0, '!'}}"},
+		{"rate %.2f", "{{0, 0, '!'}}"},
+		{"%[1]s", "{{0, 0, '!'}}"},
+		{"trailing %", "{{0, 0, '!'}}"},
+	}
+	for _, tc := range tests {
+		if got := segmentsText(segments(tc.text)); got != tc.want {
+			t.Errorf("segments of %q = %s, want %s", tc.text, got, tc.want)
+		}
+	}
+}
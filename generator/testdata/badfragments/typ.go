@@ -0,0 +1,8 @@
+package badfragments
+
+type Code int
+
+const (
+	CodeOK Code = iota
+	CodeName
+)
@@ -0,0 +1,8 @@
+package badtoml
+
+type Code int
+
+const (
+	CodeOK Code = iota
+	CodeFail
+)
@@ -0,0 +1,8 @@
+package refragments
+
+type Code int
+
+const (
+	CodeOK Code = iota
+	CodeName
+)
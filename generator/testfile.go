@@ -0,0 +1,115 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// buildTest returns the generator of the _test.go file of Config.GenTest, one test per type
+// checking its translations at test time as Check does at generation time. The locales without
+// a TOML value of each constant are listed at generation time, so that a text equal to its key
+// such as OK="OK" is a translation.
+func (g *Generator) buildTest(typeItems []string, commandLine string) *Generator {
+	t := &Generator{logf: g.logf}
+	t.Printf("// Code generated by \"i18n-stringer %s\"; DO NOT EDIT.\n", commandLine)
+	t.Printf("\n")
+	t.Printf("package %s", g.pkg.name)
+	t.Printf("\n")
+	t.Printf("import (\n")
+	t.Printf("\"sort\"\n")
+	t.Printf("\"strings\"\n")
+	t.Printf("\"testing\"\n")
+	t.Printf("\"unicode/utf8\"\n")
+	t.Printf(")\n")
+	for _, typeName := range typeItems {
+		// the constants of equal values are translated by the first one, as the generated tables
+		var items strings.Builder
+		for _, run := range splitIntoRuns(append([]Value(nil), g.values[typeName]...)) {
+			for _, value := range run {
+				missing := "nil"
+				var locales []string
+				for _, locale := range g.parser.locales {
+					if !g.parser.HasLocaleValue(value.key, locale) && (value.comment == "" || locale != g.defaultLocale) {
+						locales = append(locales, strconv.Quote(locale))
+					}
+				}
+				if len(locales) > 0 {
+					missing = "[]string{" + strings.Join(locales, ", ") + "}"
+				}
+				fmt.Fprintf(&items, "\t\t{%s, %s, %s},\n", value.originalName, strconv.Quote(value.key), missing)
+			}
+		}
+		t.Printf(i18nCatalogTest, typeName, items.String())
+	}
+	return t
+}
+
+// Arguments to format are:
+//
+//	[1]: typeName
+//	[2]: constants with their TOML keys and locales without a TOML value, one per line
+const i18nCatalogTest = `
+// TestI18nCatalog_%[1]s checks that every constant of %[1]s is translated in every locale compiled in,
+// instead of falling back to its TOML key or %[1]s[locale](n), with the fmt verbs of the default locale.
+// Locales without a TOML value of a constant are listed when generated, a text may equal its key.
+func TestI18nCatalog_%[1]s(t *testing.T) {
+	// verbs returns the sorted fmt verbs of text, the order of args may differ by locale
+	verbs := func(text string) string {
+		var res []string
+		for i := 0; i < len(text); i++ {
+			if text[i] != '%%' {
+				continue
+			}
+			i++
+			for i < len(text) && strings.IndexByte("+-# 0123456789.*[]", text[i]) >= 0 {
+				i++
+			}
+			if i < len(text) && text[i] != '%%' {
+				verb, size := utf8.DecodeRuneInString(text[i:])
+				res = append(res, "%%"+string(verb))
+				i += size - 1
+			}
+		}
+		sort.Strings(res)
+		return strings.Join(res, " ")
+	}
+
+	// contains reports whether locales has locale
+	contains := func(locales []string, locale string) bool {
+		for _, item := range locales {
+			if item == locale {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, item := range []struct {
+		value   %[1]s
+		key     string
+		missing []string // locales without a TOML value
+	}{
+%[2]s	} {
+		want := verbs(item.value.TransIndex(_%[1]s_defaultIdx))
+		for li, locale := range _%[1]s_locales {
+			text := item.value.TransIndex(li)
+			if contains(item.missing, locale) {
+				t.Errorf("%%s of locale %%s is not translated, got %%q", item.key, locale, text)
+				continue
+			}
+			if strings.HasPrefix(text, "%[1]s["+locale+"](") {
+				t.Errorf("%%s of locale %%s is not generated, got %%q", item.key, locale, text)
+				continue
+			}
+			if got := verbs(text); got != want {
+				t.Errorf("%%s of locale %%s has fmt verbs [%%s] instead of [%%s] of the default locale", item.key, locale, got, want)
+			}
+		}
+	}
+}
+`
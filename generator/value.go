@@ -0,0 +1,343 @@
+// Copyright 2021 The team jjonline Authors. All rights reserved.
+// Use of this source code is governed by a MIT License
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+)
+
+// Value represents a declared constant.
+type Value struct {
+	originalName string         // The name of the constant.
+	pos          token.Position // The position of the name of the constant.
+	key          string         // The TOML key, the name with trimmed prefix unless set by //i18n:key=Other.
+	note         string         // The note for translators set by //i18n:note "...".
+	comment      string         // The line comment text used as default locale text by -linecomment.
+	doc          string         // The doc or line comment text, the initial default locale text of Init.
+	name         string         // The name with trimmed prefix.
+	// The value is stored as a bit pattern alone. The boolean tells us
+	// whether to interpret it as an int64 or an uint64; the only place
+	// this matters is when sorting.
+	// Much of the time the str field is all we need; it is printed
+	// by Value.String.
+	value     uint64 // Will be converted to int64 when needed.
+	signed    bool   // Whether the constant is a signed type.
+	str       string // The string representation given by the "go/constant" package.
+	strVal    string // The value of a constant of string type, str is its quoted form.
+	basicType string // value of basic Type, for: int int64 uint etc
+	fragment  bool   // placeholder fragment only used as replacement value, can not be wrapped as an error
+}
+
+func (v *Value) String() string {
+	return v.str
+}
+
+// isString reports whether v is a constant of a string type
+func (v *Value) isString() bool {
+	return v.basicType == "string"
+}
+
+// json returns the JSON form of v for the embed catalog asset
+func (v *Value) json() json.RawMessage {
+	if v.isString() {
+		text, _ := json.Marshal(v.strVal)
+		return text
+	}
+	return json.RawMessage(v.str)
+}
+
+// byValue lets us sort the constants into increasing order.
+// We take care in the Less method to sort in signed or unsigned order,
+// as appropriate.
+type byValue []Value
+
+func (b byValue) Len() int      { return len(b) }
+func (b byValue) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byValue) Less(i, j int) bool {
+	if b[i].isString() {
+		return b[i].strVal < b[j].strVal
+	}
+	if b[i].signed {
+		return int64(b[i].value) < int64(b[j].value)
+	}
+	return b[i].value < b[j].value
+}
+
+// genDecl processes one declaration clause.
+func (f *srcFile) genDecl(node ast.Node) bool {
+	if f.errs.full() {
+		// Too many problems already.
+		return false
+	}
+	decl, ok := node.(*ast.GenDecl)
+	if !ok || decl.Tok != token.CONST {
+		// We only care about const declarations.
+		return true
+	}
+	// The name of the type of the constants we are declaring.
+	// Can change if this is a multi-element declaration.
+	typ := ""
+	// Loop over the elements of the declaration. Each element is a ValueSpec:
+	// a list of names possibly followed by a type, possibly followed by values.
+	// If the type and value are both missing, we carry down the type (and value,
+	// but the "go/types" package takes care of that).
+	for _, spec := range decl.Specs {
+		vSpec := spec.(*ast.ValueSpec) // Guaranteed to succeed as this is CONST.
+		if vSpec.Type != nil {
+			// "X T". We have a type. Remember it.
+			ident, ok := vSpec.Type.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			typ = ident.Name
+		}
+		if typ != f.typeName {
+			// This is not the type we're looking for.
+			continue
+		}
+		// We now have a list of names (from one line of source code) all being
+		// declared with the desired type.
+		// Grab their names and actual values and store them in f.values.
+		for _, name := range vSpec.Names {
+			if name.Name == "_" {
+				continue
+			}
+			// This dance lets the type checker find the values for us. It's a
+			// bit tricky: look up the object declared by the name, find its
+			// types.Const, and extract its value.
+			position := f.pkg.fset.Position(name.Pos())
+			obj, ok := f.pkg.defs[name]
+			if !ok {
+				f.errs.add(position, "no value for constant %s", name)
+				continue
+			}
+			basic := obj.Type().Underlying().(*types.Basic)
+			info := basic.Info()
+			value := obj.(*types.Const).Val() // Guaranteed to succeed as this is CONST.
+			if info&types.IsString != 0 {
+				// String types are looked up by a map only, the value is all we need.
+				v := Value{
+					originalName: name.Name,
+					pos:          position,
+					str:          value.ExactString(),
+					strVal:       constant.StringVal(value),
+					basicType:    basic.Name(),
+				}
+				f.appendValue(v, decl, vSpec)
+				continue
+			}
+			if info&types.IsInteger == 0 {
+				f.errs.add(position, "can't handle non-integer or non-string constant type %s", typ)
+				continue
+			}
+			if value.Kind() != constant.Int {
+				f.errs.add(position, "can't happen: constant is not an integer %s", name)
+				continue
+			}
+			i64, isInt := constant.Int64Val(value)
+			u64, isUint := constant.Uint64Val(value)
+			if !isInt && !isUint {
+				f.errs.add(position, "internal error: value of %s is not an integer: %s", name, value.String())
+				continue
+			}
+			if !isInt {
+				u64 = uint64(i64)
+			}
+			v := Value{
+				originalName: name.Name,
+				pos:          position,
+				value:        u64,
+				signed:       info&types.IsUnsigned == 0,
+				str:          value.String(),
+				basicType:    basic.Name(),
+			}
+			f.appendValue(v, decl, vSpec)
+		}
+	}
+	return false
+}
+
+// appendValue sets the TOML key of v by the flags and directives of its spec, and appends it
+// to the values of f unless skipped by //i18n:skip or its directives have problems.
+func (f *srcFile) appendValue(v Value, decl *ast.GenDecl, vSpec *ast.ValueSpec) {
+	v.name = strings.TrimPrefix(v.originalName, f.trimPrefix)
+	v.key = v.name
+	if c := vSpec.Comment; f.lineComment && c != nil {
+		v.comment = strings.TrimSpace(c.Text()) // directive comments are not part of the text
+	}
+	v.doc = docText(v.originalName, decl, vSpec)
+	if skip := f.applyDirectives(&v, decl, vSpec); skip {
+		return
+	}
+	f.values = append(f.values, v)
+}
+
+// docText returns the doc comment of a constant spec, or of the declaration when it is not grouped,
+// otherwise its line comment, as one line without the leading constant name
+func docText(name string, decl *ast.GenDecl, vSpec *ast.ValueSpec) string {
+	groups := []*ast.CommentGroup{vSpec.Doc, vSpec.Comment}
+	if !decl.Lparen.IsValid() {
+		groups = []*ast.CommentGroup{vSpec.Doc, decl.Doc, vSpec.Comment}
+	}
+	for _, group := range groups {
+		if text := strings.Join(strings.Fields(group.Text()), " "); text != "" {
+			return strings.TrimPrefix(text, name+" ")
+		}
+	}
+	return ""
+}
+
+// Helpers
+
+// directivePrefix prefix of i18n-stringer directives in the comments of a constant
+const directivePrefix = "//i18n:"
+
+// directives returns the i18n-stringer directive comments of a constant spec, in the doc comment
+// and line comment of the spec, or the doc comment of the declaration when it is not grouped.
+func directives(decl *ast.GenDecl, vSpec *ast.ValueSpec) []*ast.Comment {
+	groups := []*ast.CommentGroup{vSpec.Doc, vSpec.Comment}
+	if !decl.Lparen.IsValid() {
+		groups = append(groups, decl.Doc)
+	}
+	var items []*ast.Comment
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, directivePrefix) {
+				items = append(items, comment)
+			}
+		}
+	}
+	return items
+}
+
+// applyDirectives applies the directive comments of the constant spec to v, reports whether v is skipped,
+// problems of the directives are added to the errors of f and skip v too
+//  - //i18n:key=Other   use TOML key Other instead of the name of the constant
+//  - //i18n:skip        do not translate the constant at all, such as internal sentinels
+//  - //i18n:fragment    placeholder fragment, translation only
+//  - //i18n:note "..."  note for translators, shown beside the missing key by -check
+func (f *srcFile) applyDirectives(v *Value, decl *ast.GenDecl, vSpec *ast.ValueSpec) bool {
+	skip := false
+	for _, comment := range directives(decl, vSpec) {
+		text := strings.TrimSpace(comment.Text[len(directivePrefix):])
+		name, arg := text, ""
+		if idx := strings.IndexAny(text, "= \t"); idx >= 0 {
+			name, arg = text[:idx], strings.TrimSpace(text[idx+1:])
+		}
+		position := f.pkg.fset.Position(comment.Pos())
+		switch name {
+		case "skip":
+			skip = true
+		case "fragment":
+			v.fragment = true
+		case "key":
+			if len(vSpec.Names) > 1 {
+				f.errs.add(position, "%s applies to a single constant, not %d", comment.Text, len(vSpec.Names))
+				skip = true
+				continue
+			}
+			if !isBareKey(arg) {
+				f.errs.add(position, "%s must set a TOML bare key, eg. %skey=CodeOther", comment.Text, directivePrefix)
+				skip = true
+				continue
+			}
+			v.key = arg
+		case "note":
+			note, err := strconv.Unquote(arg)
+			if err != nil {
+				f.errs.add(position, "%s must set a quoted note, eg. %snote \"shown as page title\"", comment.Text, directivePrefix)
+				skip = true
+				continue
+			}
+			v.note = note
+		default:
+			f.errs.add(position, "unknown directive %s", comment.Text)
+			skip = true
+		}
+	}
+	return skip
+}
+
+// isBareKey reports whether key is a TOML bare key, only contain A-Za-z0-9_-
+func isBareKey(key string) bool {
+	for _, c := range key {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return false
+		}
+	}
+	return key != ""
+}
+
+// valueRange an inclusive range of constant values
+type valueRange struct {
+	lo, hi int64
+}
+
+// contains reports whether the constant value v is in the range r
+func (r valueRange) contains(v Value) bool {
+	if v.isString() {
+		return false
+	}
+	if v.signed {
+		return int64(v.value) >= r.lo && int64(v.value) <= r.hi
+	}
+	return r.hi >= 0 && v.value <= uint64(r.hi) && (r.lo < 0 || v.value >= uint64(r.lo))
+}
+
+// parseRanges parse comma-separated list of value ranges like 10000-20000, a single value is a range too
+func parseRanges(list string) ([]valueRange, error) {
+	var ranges []valueRange
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		lo, hi := item, item
+		if idx := strings.Index(item[1:], "-"); idx >= 0 {
+			lo, hi = item[:idx+1], item[idx+2:]
+		}
+		from, err1 := strconv.ParseInt(strings.TrimSpace(lo), 0, 64)
+		to, err2 := strconv.ParseInt(strings.TrimSpace(hi), 0, 64)
+		if err1 != nil || err2 != nil || from > to {
+			return nil, fmt.Errorf("-fragments option range `%s` is invalid, eg. 10000-20000", item)
+		}
+		ranges = append(ranges, valueRange{lo: from, hi: to})
+	}
+	return ranges, nil
+}
+
+// markFragments marks the placeholder fragment constants of type by value ranges and
+// TOML table [fragments], in addition to those marked by //i18n:fragment. Constants
+// sharing a value with a fragment are fragments too, since they can not be told apart.
+func (g *Generator) markFragments(typeName string, ranges []valueRange) {
+	values := g.values[typeName]
+	marked := make(map[string]bool)
+	for i := range values {
+		if len(g.parser.fragments[values[i].key]) > 0 {
+			values[i].fragment = true
+		}
+		for _, r := range ranges {
+			if r.contains(values[i]) {
+				values[i].fragment = true
+			}
+		}
+		if values[i].fragment {
+			marked[values[i].str] = true
+		}
+	}
+	for i := range values {
+		values[i].fragment = marked[values[i].str]
+	}
+}
@@ -0,0 +1,455 @@
+// Code generated by "i18n-stringer -type Perm -defaultlocale en -bitmask"; DO NOT EDIT.
+
+package test_bitmask
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[PermNone-0]
+	_ = x[PermRead-1]
+	_ = x[PermWrite-2]
+	_ = x[PermExec-4]
+	_ = x[PermAll-7]
+}
+
+var (
+	_Perm_name_0  = [...]string{"nonereadwrite", "无读写"}
+	_Perm_index_0 = [...][4]uint8{{0, 4, 8, 13}, {0, 3, 6, 9}}
+	_Perm_name_1  = [...]string{"execute", "执行"}
+	_Perm_name_2  = [...]string{"all", "全部"}
+)
+
+// _transIdx translate one CONST with locale index
+func (i Perm) _transIdx(li int) string {
+	switch {
+	case i <= 2:
+		return _Perm_name_0[li][_Perm_index_0[li][i]:_Perm_index_0[li][i+1]]
+	case i == 4:
+		return _Perm_name_1[li]
+	case i == 7:
+		return _Perm_name_2[li]
+	default:
+		return "Perm[" + _Perm_locales[li] + "](" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// _Perm_locales All supported locales indexed by value of _Perm_supported
+var _Perm_locales = []string{"en", "zh-cn"}
+
+// _Perm_supported All supported locales record, locale to index of _Perm_locales
+var _Perm_supported = map[string]int{"en": 0, "zh-cn": 1}
+
+// _Perm_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _Perm_defaultLocale = "en"
+
+// _Perm_defaultIdx index of default locale in _Perm_locales
+var _Perm_defaultIdx = _Perm_supported[_Perm_defaultLocale]
+
+// _Perm_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _Perm_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i Perm) String() string {
+	return i._trans(_Perm_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i Perm) Error() string {
+	return i._trans(_Perm_defaultIdx)
+}
+
+// Code get original type uint8 value
+func (i Perm) Code() uint8 {
+	return uint8(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i Perm) Wrap(err error, locale string, args ...interface{}) *I18nPermErrorWrap {
+	i._mustNotFragment()
+	return &I18nPermErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _Perm_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i Perm) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nPermErrorWrap {
+	i._mustNotFragment()
+	return &I18nPermErrorWrap{err: err, origin: i, locale: _Perm_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i Perm) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("Perm(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nPermErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nPermErrorWrap struct {
+	err    error         // wrap another error
+	origin Perm          // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nPermErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nPermErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nPermErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nPermErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nPermErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nPermErrorWrap) Value() Perm {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nPermErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i Perm) IsLocaleSupport(locale string) bool {
+	return _Perm_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _Perm_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Perm) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_Perm_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Perm) Trans(locale string, args ...interface{}) string {
+	return i._trans(_Perm_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i Perm) LocaleIndex(locale string) int {
+	return _Perm_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Perm) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_Perm_locales) {
+		li = _Perm_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _Perm_isLocaleSupport(locale string) bool {
+	return _Perm_lookupLocale(locale) >= 0
+}
+
+// _Perm_lookupLocale resolve language locale name to index of _Perm_locales, -1 when it is not supported
+func _Perm_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-cn":
+		return 1
+	}
+	return -1
+}
+
+// _Perm_localeIdx resolve language locale name to index of _Perm_locales.
+// It returns index of default locale when _Perm_isLocaleSupport is false
+func _Perm_localeIdx(locale string) int {
+	if li := _Perm_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _Perm_defaultIdx
+}
+
+// _Perm_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _Perm_locales.
+// It returns index of default locale when _Perm_isLocaleSupport is false
+func _Perm_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _Perm_defaultIdx
+	}
+	if v, ok := ctx.Value(_Perm_ctxKey).(string); ok {
+		return _Perm_localeIdx(v)
+	}
+	return _Perm_defaultIdx
+}
+
+// _Perm_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _Perm_isLocaleSupport is false
+func _Perm_localeFromCtxWithFallback(ctx context.Context) string {
+	return _Perm_locales[_Perm_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i Perm) IsFragment() bool {
+	return false
+}
+
+// _Perm_bits single bit constants of Perm in ascending order
+var _Perm_bits = []Perm{PermRead, PermWrite, PermExec}
+
+// _Perm_separators separator joining translations of set bits by locale, set by TOML table [bitmask]
+var _Perm_separators = map[string]string{"en": " | ", "zh-cn": "、"}
+
+// _transBits translate a defined value as it is, a combined value as translations of its set bits
+// joined by the separator of the locale, unknown bits are translated as fallback text
+func (i Perm) _transBits(li int) string {
+	switch i {
+	case PermNone, PermRead, PermWrite, PermExec, PermAll:
+		return i._transIdx(li)
+	}
+	var b strings.Builder
+	rest := i
+	for _, bit := range _Perm_bits {
+		if rest&bit == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(_Perm_separators[_Perm_locales[li]])
+		}
+		b.WriteString(bit._transIdx(li))
+		rest &^= bit
+	}
+	if rest != 0 || b.Len() == 0 {
+		if b.Len() > 0 {
+			b.WriteString(_Perm_separators[_Perm_locales[li]])
+		}
+		b.WriteString("Perm[" + _Perm_locales[li] + "](" + strconv.FormatInt(int64(rest), 10) + ")")
+	}
+	return b.String()
+}
+
+// _Perm_hasVerbs whether any translation of Perm has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _Perm_hasVerbs = false
+
+// _Perm_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _Perm_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _Perm_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _Perm_fmt = [][][]_Perm_seg{
+	{}, // en
+	{}, // zh-cn
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i Perm) _segs(li int) []_Perm_seg {
+	return nil
+}
+
+// _Perm_bufPool buffers formatting translations with args
+var _Perm_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _Perm_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i Perm) _trans(li int, args ...interface{}) string {
+	return i._format(i._transBits(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i Perm) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_Perm_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _Perm_bufPool.Get().(*[]byte)
+	*buf = _Perm_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_Perm_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Perm) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _Perm_localeIdx(locale)
+	msg := i._transBits(li)
+	if len(args) == 0 || !_Perm_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _Perm_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Perm) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _Perm_localeIdx(locale)
+	msg := i._transBits(li)
+	if len(args) == 0 || !_Perm_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _Perm_bufPool.Get().(*[]byte)
+	*buf = _Perm_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_Perm_bufPool.Put(buf)
+	return n, err
+}
+
+// _Perm_sprintf format msg with args, args of type Perm translated use locale index li
+func _Perm_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _Perm_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _Perm_transArg translate arg use locale index li when arg is a value of Perm, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _Perm_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case Perm:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_Perm_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _Perm_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _Perm_sprintf
+func _Perm_appendf(dst []byte, msg string, segs []_Perm_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case Perm:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_Perm_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _Perm_sprintf(msg, li, args)...)
+}
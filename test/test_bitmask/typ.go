@@ -0,0 +1,13 @@
+package test_bitmask
+
+//go:generate $GOPATH/bin/i18n-stringer -type Perm -defaultlocale en -bitmask
+
+type Perm uint8
+
+const (
+	PermNone Perm = 0
+	PermRead Perm = 1 << (iota - 1)
+	PermWrite
+	PermExec
+	PermAll = PermRead | PermWrite | PermExec
+)
@@ -0,0 +1,18 @@
+package test_directives
+
+//go:generate $GOPATH/bin/i18n-stringer -type Code -defaultlocale en
+
+type Code int
+
+const (
+	// CodeOK success
+	//i18n:note "shown after a form is saved"
+	CodeOK Code = iota + 1
+	// CodeNotFound renamed from CodeMissing, the TOML key is kept
+	//i18n:key=CodeMissing
+	CodeNotFound
+	CodeUnknown //i18n:skip
+	//i18n:fragment
+	//i18n:note "field name used in messages, keep it short"
+	FieldName
+)
@@ -0,0 +1,32 @@
+package test_fragments
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestShowKeys(t *testing.T) {
+	defer SetI18nCodeShowKeys("")
+	tests := []struct {
+		mode string
+		want string
+	}{
+		{"", "name is required"},
+		{"key", "Code.CodeRequired(2)"},
+		{"annotate", "name {Code.FieldName(10000)} is required {Code.CodeRequired(2)}"},
+		{"off", "name is required"},
+	}
+	for _, tc := range tests {
+		SetI18nCodeShowKeys(tc.mode)
+		if got := CodeRequired.Trans("en", FieldName); got != tc.want {
+			t.Errorf("mode %q: Trans %q, want %q", tc.mode, got, tc.want)
+		}
+		if got := string(CodeRequired.AppendTrans(nil, "en", FieldName)); got != tc.want {
+			t.Errorf("mode %q: AppendTrans %q, want %q", tc.mode, got, tc.want)
+		}
+		var w bytes.Buffer
+		if _, _ = CodeRequired.WriteTrans(&w, "en", FieldName); w.String() != tc.want {
+			t.Errorf("mode %q: WriteTrans %q, want %q", tc.mode, w.String(), tc.want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package test_fragments
+
+//go:generate $GOPATH/bin/i18n-stringer -type Code -defaultlocale en -fragments 10000-20000 -showkeys
+
+type Code int
+
+const (
+	CodeOK Code = iota + 1
+	CodeRequired
+	CodeTooLong
+)
+
+// placeholder fragments by -fragments range
+const (
+	FieldName Code = iota + 10000
+	FieldEmail
+)
+
+const (
+	// FieldPhone placeholder fragment by directive
+	//i18n:fragment
+	FieldPhone Code = 30000
+	FieldAge   Code = 30001 //i18n:fragment
+	// FieldNick placeholder fragment by TOML table [fragments]
+	FieldNick Code = 30002
+)
@@ -0,0 +1,11 @@
+package test_gentest
+
+//go:generate $GOPATH/bin/i18n-stringer -type Code -defaultlocale en -linecomment -gentest
+
+type Code int
+
+const (
+	OK       Code = iota + 1
+	CodeFail      // Failed to save %s
+	CodeBusy
+)
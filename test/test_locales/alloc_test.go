@@ -0,0 +1,87 @@
+package test_locales
+
+import (
+	"bytes"
+	"testing"
+)
+
+// buffers the tests and benchmarks append and write to, large enough for any translation
+var (
+	buf = make([]byte, 0, 256)
+	w   = bytes.NewBuffer(make([]byte, 0, 256))
+)
+
+// TestTransAllocs checks the allocations of translations, formatted ones only allocate the string Trans returns
+func TestTransAllocs(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+		want float64
+	}{
+		{"Trans", func() { sink = CodeOK.Trans("zh-cn") }, 0},
+		{"TransIndex", func() { sink = CodeOK.TransIndex(CodeOK.LocaleIndex("zh-cn")) }, 0},
+		{"Trans unsupported locale", func() { sink = CodeOK.Trans("xx") }, 0},
+		{"Trans verb", func() { sink = CodeLimit.Trans("en", 10) }, 1},
+		{"AppendTrans", func() { buf = CodeOK.AppendTrans(buf[:0], "zh-cn") }, 0},
+		{"AppendTrans verb", func() { buf = CodeLimit.AppendTrans(buf[:0], "zh-cn", 10) }, 0},
+		{"WriteTrans", func() {
+			w.Reset()
+			_, _ = CodeOK.WriteTrans(w, "zh-cn")
+		}, 0},
+		{"WriteTrans verb", func() {
+			w.Reset()
+			_, _ = CodeLimit.WriteTrans(w, "en", 10)
+		}, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if allocs := testing.AllocsPerRun(100, tc.f); allocs != tc.want {
+				t.Errorf("%v allocations per run, want %v", allocs, tc.want)
+			}
+		})
+	}
+}
+
+func BenchmarkTransNoVerbs(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		sink = CodeOK.Trans("zh-cn")
+	}
+}
+
+func BenchmarkTransVerb(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		sink = CodeLimit.Trans("zh-cn", 10)
+	}
+}
+
+func BenchmarkAppendTrans(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = CodeOK.AppendTrans(buf[:0], "zh-cn")
+	}
+}
+
+func BenchmarkAppendTransVerb(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = CodeLimit.AppendTrans(buf[:0], "zh-cn", 10)
+	}
+}
+
+func BenchmarkWriteTrans(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		w.Reset()
+		_, _ = CodeOK.WriteTrans(w, "zh-cn")
+	}
+}
+
+func BenchmarkWriteTransVerb(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		w.Reset()
+		_, _ = CodeLimit.WriteTrans(w, "en", 10)
+	}
+}
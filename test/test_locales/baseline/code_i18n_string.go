@@ -0,0 +1,300 @@
+// Code generated by "i18n-stringer -type Code -defaultlocale en"; DO NOT EDIT.
+
+package baseline
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[CodeOK-1]
+	_ = x[CodeFail-2]
+	_ = x[CodeNotFound-3]
+	_ = x[CodeDenied-4]
+	_ = x[CodeLimit-5]
+}
+
+const (
+	_Code_Ar_name   = "تمت العملية بنجاحفشلت العمليةغير موجودتم رفض الوصولتم تجاوز الحد %d"
+	_Code_De_name   = "Vorgang erfolgreichVorgang fehlgeschlagenNicht gefundenZugriff verweigertLimit von %d überschritten"
+	_Code_En_name   = "Operation succeededOperation failedNot foundAccess deniedLimit of %d exceeded"
+	_Code_Es_name   = "Operación exitosaLa operación fallóNo encontradoAcceso denegadoLímite de %d superado"
+	_Code_Fa_name   = "عملیات موفق بودعملیات ناموفق بودیافت نشددسترسی رد شداز حد %d فراتر رفت"
+	_Code_Fr_name   = "Opération réussieÉchec de l'opérationIntrouvableAccès refuséLimite de %d dépassée"
+	_Code_He_name   = "הפעולה הצליחההפעולה נכשלהלא נמצאהגישה נדחתהחריגה ממגבלה של %d"
+	_Code_Hi_name   = "कार्य सफल रहाकार्य विफल रहानहीं मिलापहुँच अस्वीकृत%d की सीमा पार हो गई"
+	_Code_Id_name   = "Operasi berhasilOperasi gagalTidak ditemukanAkses ditolakBatas %d terlampaui"
+	_Code_It_name   = "Operazione riuscitaOperazione non riuscitaNon trovatoAccesso negatoLimite di %d superato"
+	_Code_Ja_name   = "操作に成功しました操作に失敗しました見つかりませんアクセスが拒否されました上限 %d を超えました"
+	_Code_Ko_name   = "작업에 성공했습니다작업에 실패했습니다찾을 수 없습니다접근이 거부되었습니다한도 %d을(를) 초과했습니다"
+	_Code_Nl_name   = "Bewerking geslaagdBewerking misluktNiet gevondenToegang geweigerdLimiet van %d overschreden"
+	_Code_Pl_name   = "Operacja zakończona sukcesemOperacja nie powiodła sięNie znalezionoOdmowa dostępuPrzekroczono limit %d"
+	_Code_Pt_name   = "Operação concluídaA operação falhouNão encontradoAcesso negadoLimite de %d excedido"
+	_Code_Ru_name   = "Операция выполненаОперация не выполненаНе найденоДоступ запрещёнПревышен лимит %d"
+	_Code_Sv_name   = "Åtgärden lyckadesÅtgärden misslyckadesHittades inteÅtkomst nekadGränsen %d överskreds"
+	_Code_Th_name   = "ดำเนินการสำเร็จดำเนินการล้มเหลวไม่พบการเข้าถึงถูกปฏิเสธเกินขีดจำกัด %d"
+	_Code_Tr_name   = "İşlem başarılıİşlem başarısızBulunamadıErişim reddedildi%d sınırı aşıldı"
+	_Code_Uk_name   = "Операцію виконаноОперацію не виконаноНе знайденоДоступ забороненоПеревищено ліміт %d"
+	_Code_Vi_name   = "Thao tác thành côngThao tác thất bạiKhông tìm thấyTruy cập bị từ chốiVượt quá giới hạn %d"
+	_Code_ZhCn_name = "操作成功操作失败未找到拒绝访问超过上限 %d"
+	_Code_ZhHk_name = "操作成功操作失敗找不到拒絕存取超過上限 %d"
+	_Code_ZhTw_name = "操作成功操作失敗找不到拒絕存取超過上限 %d"
+)
+
+var (
+	_Code_Ar_index   = [...]uint8{0, 32, 55, 72, 96, 123}
+	_Code_De_index   = [...]uint8{0, 19, 41, 55, 73, 100}
+	_Code_En_index   = [...]uint8{0, 19, 35, 44, 57, 77}
+	_Code_Es_index   = [...]uint8{0, 18, 38, 51, 66, 88}
+	_Code_Fa_index   = [...]uint8{0, 28, 60, 75, 97, 127}
+	_Code_Fr_index   = [...]uint8{0, 19, 41, 52, 66, 89}
+	_Code_He_index   = [...]uint8{0, 25, 48, 61, 82, 113}
+	_Code_Hi_index   = [...]uint8{0, 35, 73, 98, 138, 184}
+	_Code_Id_index   = [...]uint8{0, 16, 29, 44, 57, 76}
+	_Code_It_index   = [...]uint8{0, 19, 42, 53, 67, 88}
+	_Code_Ja_index   = [...]uint8{0, 27, 54, 75, 111, 139}
+	_Code_Ko_index   = [...]uint8{0, 28, 56, 79, 110, 146}
+	_Code_Nl_index   = [...]uint8{0, 18, 35, 48, 65, 91}
+	_Code_Pl_index   = [...]uint8{0, 29, 56, 70, 85, 106}
+	_Code_Pt_index   = [...]uint8{0, 21, 40, 55, 68, 89}
+	_Code_Ru_index   = [...]uint8{0, 35, 75, 94, 123, 153}
+	_Code_Sv_index   = [...]uint8{0, 19, 42, 55, 69, 92}
+	_Code_Th_index   = [...]uint8{0, 45, 93, 108, 165, 204}
+	_Code_Tr_index   = [...]uint8{0, 19, 39, 50, 68, 90}
+	_Code_Uk_index   = [...]uint8{0, 33, 71, 92, 125, 159}
+	_Code_Vi_index   = [...]uint8{0, 22, 44, 62, 89, 117}
+	_Code_ZhCn_index = [...]uint8{0, 12, 24, 33, 45, 60}
+	_Code_ZhHk_index = [...]uint8{0, 12, 24, 33, 45, 60}
+	_Code_ZhTw_index = [...]uint8{0, 12, 24, 33, 45, 60}
+)
+
+// _transOne translate one CONST
+func (i Code) _transOne(locale string) string {
+	i -= 1
+	if i < 0 || i >= Code(len(_Code_Ar_index)-1) {
+		return "Code[" + locale + "](" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+
+	switch locale {
+	case "ar":
+		return _Code_Ar_name[_Code_Ar_index[i]:_Code_Ar_index[i+1]]
+	case "de":
+		return _Code_De_name[_Code_De_index[i]:_Code_De_index[i+1]]
+	case "en":
+		return _Code_En_name[_Code_En_index[i]:_Code_En_index[i+1]]
+	case "es":
+		return _Code_Es_name[_Code_Es_index[i]:_Code_Es_index[i+1]]
+	case "fa":
+		return _Code_Fa_name[_Code_Fa_index[i]:_Code_Fa_index[i+1]]
+	case "fr":
+		return _Code_Fr_name[_Code_Fr_index[i]:_Code_Fr_index[i+1]]
+	case "he":
+		return _Code_He_name[_Code_He_index[i]:_Code_He_index[i+1]]
+	case "hi":
+		return _Code_Hi_name[_Code_Hi_index[i]:_Code_Hi_index[i+1]]
+	case "id":
+		return _Code_Id_name[_Code_Id_index[i]:_Code_Id_index[i+1]]
+	case "it":
+		return _Code_It_name[_Code_It_index[i]:_Code_It_index[i+1]]
+	case "ja":
+		return _Code_Ja_name[_Code_Ja_index[i]:_Code_Ja_index[i+1]]
+	case "ko":
+		return _Code_Ko_name[_Code_Ko_index[i]:_Code_Ko_index[i+1]]
+	case "nl":
+		return _Code_Nl_name[_Code_Nl_index[i]:_Code_Nl_index[i+1]]
+	case "pl":
+		return _Code_Pl_name[_Code_Pl_index[i]:_Code_Pl_index[i+1]]
+	case "pt":
+		return _Code_Pt_name[_Code_Pt_index[i]:_Code_Pt_index[i+1]]
+	case "ru":
+		return _Code_Ru_name[_Code_Ru_index[i]:_Code_Ru_index[i+1]]
+	case "sv":
+		return _Code_Sv_name[_Code_Sv_index[i]:_Code_Sv_index[i+1]]
+	case "th":
+		return _Code_Th_name[_Code_Th_index[i]:_Code_Th_index[i+1]]
+	case "tr":
+		return _Code_Tr_name[_Code_Tr_index[i]:_Code_Tr_index[i+1]]
+	case "uk":
+		return _Code_Uk_name[_Code_Uk_index[i]:_Code_Uk_index[i+1]]
+	case "vi":
+		return _Code_Vi_name[_Code_Vi_index[i]:_Code_Vi_index[i+1]]
+	case "zh-cn":
+		return _Code_ZhCn_name[_Code_ZhCn_index[i]:_Code_ZhCn_index[i+1]]
+	case "zh-hk":
+		return _Code_ZhHk_name[_Code_ZhHk_index[i]:_Code_ZhHk_index[i+1]]
+	case "zh-tw":
+		return _Code_ZhTw_name[_Code_ZhTw_index[i]:_Code_ZhTw_index[i+1]]
+	default:
+		// Normally unreachable, should not happen but be cautious
+		return ""
+	}
+}
+
+// _Code_supported All supported locales record
+var _Code_supported = map[string]int{"ar": 0, "de": 1, "en": 2, "es": 3, "fa": 4, "fr": 5, "he": 6, "hi": 7, "id": 8, "it": 9, "ja": 10, "ko": 11, "nl": 12, "pl": 13, "pt": 14, "ru": 15, "sv": 16, "th": 17, "tr": 18, "uk": 19, "vi": 20, "zh-cn": 21, "zh-hk": 22, "zh-tw": 23}
+
+// _Code_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _Code_defaultLocale = "en"
+
+// _Code_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _Code_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i Code) String() string {
+	return i._trans(_Code_defaultLocale)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i Code) Error() string {
+	return i._trans(_Code_defaultLocale)
+}
+
+// Code get original type int value
+func (i Code) Code() int {
+	return int(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i Code) Wrap(err error, locale string, args ...interface{}) *I18nCodeErrorWrap {
+	return &I18nCodeErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const
+//   - ctx context with Value use Key from _Code_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i Code) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nCodeErrorWrap {
+	return &I18nCodeErrorWrap{err: err, origin: i, locale: _Code_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// I18nCodeErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nCodeErrorWrap struct {
+	err    error         // wrap another error
+	origin Code          // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nCodeErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nCodeErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nCodeErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nCodeErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nCodeErrorWrap) Value() Code {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nCodeErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i Code) IsLocaleSupport(locale string) bool {
+	return _Code_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _Code_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value type of Code, or type of string
+func (i Code) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_Code_localeFromCtxWithFallback(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value type of Code, or type of string
+func (i Code) Trans(locale string, args ...interface{}) string {
+	if !_Code_isLocaleSupport(locale) {
+		locale = _Code_defaultLocale
+	}
+	return i._trans(locale, args...)
+}
+
+func _Code_isLocaleSupport(locale string) bool {
+	_, ok := _Code_supported[locale]
+	return ok
+}
+
+// _Code_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _Code_isLocaleSupport is false
+func _Code_localeFromCtxWithFallback(ctx context.Context) string {
+	if ctx == nil {
+		return _Code_defaultLocale
+	}
+	v := ctx.Value(_Code_ctxKey)
+	if v == nil {
+		return _Code_defaultLocale
+	}
+	if vv, ok := v.(string); ok && _Code_isLocaleSupport(vv) {
+		return vv
+	}
+	return _Code_defaultLocale
+}
+
+// _trans trustworthy parameters inside method
+//   - locale i18n local
+//   - args   value type of Code, or type of string
+func (i Code) _trans(locale string, args ...interface{}) string {
+	msg := i._transOne(locale)
+	if len(args) > 0 {
+		var com []interface{}
+		for _, arg := range args {
+			if typ, ok := arg.(Code); ok {
+				com = append(com, typ._transOne(locale))
+			} else {
+				com = append(com, arg) // arg as string scalar
+			}
+		}
+		return fmt.Sprintf(msg, com...)
+	}
+	return msg
+}
@@ -0,0 +1,14 @@
+// Package baseline is test_locales as generated from ../i18n before the locale index, by
+// i18n-stringer as of commit 02b052e. It is kept as generated then, without go:generate,
+// so that the benchmarks of test_locales compare the generated code with it.
+package baseline
+
+type Code int
+
+const (
+	CodeOK Code = iota + 1
+	CodeFail
+	CodeNotFound
+	CodeDenied
+	CodeLimit
+)
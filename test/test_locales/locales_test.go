@@ -0,0 +1,76 @@
+package test_locales
+
+import (
+	"testing"
+
+	"github.com/jjonline/i18n-stringer/test/test_locales/baseline"
+)
+
+// codes the constants translated by the benchmarks, without fmt verbs
+var codes = []Code{CodeOK, CodeFail, CodeNotFound, CodeDenied}
+
+// sink keeps the results of the benchmarks alive
+var sink string
+
+// TestBaseline checks that the generated code translates the constants as the code generated before
+// the locale index, whose fallback text of undefined values was off by one
+func TestBaseline(t *testing.T) {
+	for _, locale := range append(_Code_locales, "xx") {
+		for _, code := range append(codes, CodeLimit) {
+			if got, want := code.Trans(locale), baseline.Code(code).Trans(locale); got != want {
+				t.Errorf("Trans(%d, %q) = %q, baseline %q", code, locale, got, want)
+			}
+		}
+		if got, want := CodeLimit.Trans(locale, 10), baseline.CodeLimit.Trans(locale, 10); got != want {
+			t.Errorf("Trans(%d, %q, 10) = %q, baseline %q", CodeLimit, locale, got, want)
+		}
+	}
+}
+
+func BenchmarkTrans(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		sink = codes[n%len(codes)].Trans(_Code_locales[n%len(_Code_locales)])
+	}
+}
+
+func BenchmarkTransIndex(b *testing.B) {
+	b.ReportAllocs()
+	li := make([]int, len(_Code_locales))
+	for k, locale := range _Code_locales {
+		li[k] = CodeOK.LocaleIndex(locale)
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		sink = codes[n%len(codes)].TransIndex(li[n%len(li)])
+	}
+}
+
+func BenchmarkBaselineTrans(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		sink = baseline.Code(codes[n%len(codes)]).Trans(_Code_locales[n%len(_Code_locales)])
+	}
+}
+
+// BenchmarkTransOneLocale translates in a single locale, as one request does
+func BenchmarkTransOneLocale(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		sink = codes[n%len(codes)].Trans("zh-tw")
+	}
+}
+
+func BenchmarkBaselineTransOneLocale(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		sink = baseline.Code(codes[n%len(codes)]).Trans("zh-tw")
+	}
+}
+
+func BenchmarkBaselineTransVerb(b *testing.B) {
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		sink = baseline.CodeLimit.Trans("zh-cn", 10)
+	}
+}
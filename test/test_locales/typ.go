@@ -0,0 +1,13 @@
+package test_locales
+
+//go:generate $GOPATH/bin/i18n-stringer -type Code -defaultlocale en
+
+type Code int
+
+const (
+	CodeOK Code = iota + 1
+	CodeFail
+	CodeNotFound
+	CodeDenied
+	CodeLimit
+)
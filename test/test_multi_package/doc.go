@@ -0,0 +1,4 @@
+// Package test_multi_package generates the types of all sub packages by a single run.
+package test_multi_package
+
+//go:generate $GOPATH/bin/i18n-stringer -type UserCode,OrderCode,OrderStatus -defaultlocale en ./...
@@ -0,0 +1,821 @@
+// Code generated by "i18n-stringer -type UserCode,OrderCode,OrderStatus -defaultlocale en ./..."; DO NOT EDIT.
+
+package order
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[OrderNotFound-1]
+	_ = x[OrderPaid-2]
+}
+
+var (
+	_OrderCode_ids = [...][2]uint8{{0, 1}, {2, 3}}
+)
+
+// _transIdx translate one CONST with locale index
+func (i OrderCode) _transIdx(li int) string {
+	i -= 1
+	if i < 0 || i >= OrderCode(len(_OrderCode_ids[0])) {
+		return "OrderCode[" + _OrderCode_locales[li] + "](" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _OrderCode_poolText(int(_OrderCode_ids[li][i]))
+}
+
+// _OrderCode_locales All supported locales indexed by value of _OrderCode_supported
+var _OrderCode_locales = []string{"en", "zh-cn"}
+
+// _OrderCode_supported All supported locales record, locale to index of _OrderCode_locales
+var _OrderCode_supported = map[string]int{"en": 0, "zh-cn": 1}
+
+// _OrderCode_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _OrderCode_defaultLocale = "en"
+
+// _OrderCode_defaultIdx index of default locale in _OrderCode_locales
+var _OrderCode_defaultIdx = _OrderCode_supported[_OrderCode_defaultLocale]
+
+// _OrderCode_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _OrderCode_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i OrderCode) String() string {
+	return i._trans(_OrderCode_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i OrderCode) Error() string {
+	return i._trans(_OrderCode_defaultIdx)
+}
+
+// Code get original type int value
+func (i OrderCode) Code() int {
+	return int(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i OrderCode) Wrap(err error, locale string, args ...interface{}) *I18nOrderCodeErrorWrap {
+	i._mustNotFragment()
+	return &I18nOrderCodeErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _OrderCode_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i OrderCode) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nOrderCodeErrorWrap {
+	i._mustNotFragment()
+	return &I18nOrderCodeErrorWrap{err: err, origin: i, locale: _OrderCode_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i OrderCode) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("OrderCode(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nOrderCodeErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nOrderCodeErrorWrap struct {
+	err    error         // wrap another error
+	origin OrderCode     // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nOrderCodeErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nOrderCodeErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nOrderCodeErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nOrderCodeErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nOrderCodeErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nOrderCodeErrorWrap) Value() OrderCode {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nOrderCodeErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i OrderCode) IsLocaleSupport(locale string) bool {
+	return _OrderCode_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _OrderCode_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderCode) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_OrderCode_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderCode) Trans(locale string, args ...interface{}) string {
+	return i._trans(_OrderCode_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i OrderCode) LocaleIndex(locale string) int {
+	return _OrderCode_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderCode) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_OrderCode_locales) {
+		li = _OrderCode_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _OrderCode_isLocaleSupport(locale string) bool {
+	return _OrderCode_lookupLocale(locale) >= 0
+}
+
+// _OrderCode_lookupLocale resolve language locale name to index of _OrderCode_locales, -1 when it is not supported
+func _OrderCode_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-cn":
+		return 1
+	}
+	return -1
+}
+
+// _OrderCode_localeIdx resolve language locale name to index of _OrderCode_locales.
+// It returns index of default locale when _OrderCode_isLocaleSupport is false
+func _OrderCode_localeIdx(locale string) int {
+	if li := _OrderCode_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _OrderCode_defaultIdx
+}
+
+// _OrderCode_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _OrderCode_locales.
+// It returns index of default locale when _OrderCode_isLocaleSupport is false
+func _OrderCode_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _OrderCode_defaultIdx
+	}
+	if v, ok := ctx.Value(_OrderCode_ctxKey).(string); ok {
+		return _OrderCode_localeIdx(v)
+	}
+	return _OrderCode_defaultIdx
+}
+
+// _OrderCode_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _OrderCode_isLocaleSupport is false
+func _OrderCode_localeFromCtxWithFallback(ctx context.Context) string {
+	return _OrderCode_locales[_OrderCode_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i OrderCode) IsFragment() bool {
+	return false
+}
+
+// _OrderCode_hasVerbs whether any translation of OrderCode has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _OrderCode_hasVerbs = false
+
+// _OrderCode_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _OrderCode_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _OrderCode_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _OrderCode_fmt = [][][]_OrderCode_seg{
+	{}, // en
+	{}, // zh-cn
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i OrderCode) _segs(li int) []_OrderCode_seg {
+	return nil
+}
+
+// _OrderCode_bufPool buffers formatting translations with args
+var _OrderCode_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _OrderCode_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i OrderCode) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i OrderCode) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_OrderCode_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _OrderCode_bufPool.Get().(*[]byte)
+	*buf = _OrderCode_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_OrderCode_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderCode) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _OrderCode_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_OrderCode_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _OrderCode_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderCode) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _OrderCode_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_OrderCode_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _OrderCode_bufPool.Get().(*[]byte)
+	*buf = _OrderCode_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_OrderCode_bufPool.Put(buf)
+	return n, err
+}
+
+// _OrderCode_sprintf format msg with args, args of type OrderCode translated use locale index li
+func _OrderCode_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _OrderCode_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _OrderCode_transArg translate arg use locale index li when arg is a value of OrderCode, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _OrderCode_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case OrderCode:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_OrderCode_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _OrderCode_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _OrderCode_sprintf
+func _OrderCode_appendf(dst []byte, msg string, segs []_OrderCode_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case OrderCode:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_OrderCode_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _OrderCode_sprintf(msg, li, args)...)
+}
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[len(OrderStatusOpen)-len("open")]
+	_ = x[len(OrderStatusClosed)-len("closed")]
+}
+
+var (
+	_OrderStatus_ids = [...][2]uint8{{4, 5}, {6, 7}}
+	_OrderStatus_map = map[OrderStatus]uint8{
+		OrderStatusClosed: 0,
+		OrderStatusOpen:   1,
+	}
+)
+
+// _transIdx translate one CONST with locale index
+func (i OrderStatus) _transIdx(li int) string {
+	if n, ok := _OrderStatus_map[i]; ok {
+		return _OrderCode_poolText(int(_OrderStatus_ids[li][n]))
+	}
+	return "OrderStatus[" + _OrderStatus_locales[li] + "](" + string(i) + ")"
+}
+
+// _OrderStatus_locales All supported locales indexed by value of _OrderStatus_supported
+var _OrderStatus_locales = []string{"en", "zh-cn"}
+
+// _OrderStatus_supported All supported locales record, locale to index of _OrderStatus_locales
+var _OrderStatus_supported = map[string]int{"en": 0, "zh-cn": 1}
+
+// _OrderStatus_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _OrderStatus_defaultLocale = "en"
+
+// _OrderStatus_defaultIdx index of default locale in _OrderStatus_locales
+var _OrderStatus_defaultIdx = _OrderStatus_supported[_OrderStatus_defaultLocale]
+
+// _OrderStatus_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _OrderStatus_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i OrderStatus) String() string {
+	return i._trans(_OrderStatus_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i OrderStatus) Error() string {
+	return i._trans(_OrderStatus_defaultIdx)
+}
+
+// Code get original type string value
+func (i OrderStatus) Code() string {
+	return string(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i OrderStatus) Wrap(err error, locale string, args ...interface{}) *I18nOrderStatusErrorWrap {
+	i._mustNotFragment()
+	return &I18nOrderStatusErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _OrderStatus_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i OrderStatus) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nOrderStatusErrorWrap {
+	i._mustNotFragment()
+	return &I18nOrderStatusErrorWrap{err: err, origin: i, locale: _OrderStatus_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i OrderStatus) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("OrderStatus(" + string(i) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nOrderStatusErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nOrderStatusErrorWrap struct {
+	err    error         // wrap another error
+	origin OrderStatus   // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nOrderStatusErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nOrderStatusErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nOrderStatusErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nOrderStatusErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nOrderStatusErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nOrderStatusErrorWrap) Value() OrderStatus {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nOrderStatusErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i OrderStatus) IsLocaleSupport(locale string) bool {
+	return _OrderStatus_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _OrderStatus_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderStatus) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_OrderStatus_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderStatus) Trans(locale string, args ...interface{}) string {
+	return i._trans(_OrderStatus_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i OrderStatus) LocaleIndex(locale string) int {
+	return _OrderStatus_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderStatus) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_OrderStatus_locales) {
+		li = _OrderStatus_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _OrderStatus_isLocaleSupport(locale string) bool {
+	return _OrderStatus_lookupLocale(locale) >= 0
+}
+
+// _OrderStatus_lookupLocale resolve language locale name to index of _OrderStatus_locales, -1 when it is not supported
+func _OrderStatus_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-cn":
+		return 1
+	}
+	return -1
+}
+
+// _OrderStatus_localeIdx resolve language locale name to index of _OrderStatus_locales.
+// It returns index of default locale when _OrderStatus_isLocaleSupport is false
+func _OrderStatus_localeIdx(locale string) int {
+	if li := _OrderStatus_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _OrderStatus_defaultIdx
+}
+
+// _OrderStatus_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _OrderStatus_locales.
+// It returns index of default locale when _OrderStatus_isLocaleSupport is false
+func _OrderStatus_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _OrderStatus_defaultIdx
+	}
+	if v, ok := ctx.Value(_OrderStatus_ctxKey).(string); ok {
+		return _OrderStatus_localeIdx(v)
+	}
+	return _OrderStatus_defaultIdx
+}
+
+// _OrderStatus_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _OrderStatus_isLocaleSupport is false
+func _OrderStatus_localeFromCtxWithFallback(ctx context.Context) string {
+	return _OrderStatus_locales[_OrderStatus_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i OrderStatus) IsFragment() bool {
+	return false
+}
+
+// _OrderStatus_hasVerbs whether any translation of OrderStatus has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _OrderStatus_hasVerbs = false
+
+// _OrderStatus_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _OrderStatus_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _OrderStatus_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _OrderStatus_fmt = [][][]_OrderStatus_seg{
+	{}, // en
+	{}, // zh-cn
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i OrderStatus) _segs(li int) []_OrderStatus_seg {
+	return nil
+}
+
+// _OrderStatus_bufPool buffers formatting translations with args
+var _OrderStatus_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _OrderStatus_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i OrderStatus) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i OrderStatus) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_OrderStatus_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _OrderStatus_bufPool.Get().(*[]byte)
+	*buf = _OrderStatus_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_OrderStatus_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderStatus) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _OrderStatus_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_OrderStatus_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _OrderStatus_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i OrderStatus) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _OrderStatus_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_OrderStatus_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _OrderStatus_bufPool.Get().(*[]byte)
+	*buf = _OrderStatus_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_OrderStatus_bufPool.Put(buf)
+	return n, err
+}
+
+// _OrderStatus_sprintf format msg with args, args of type OrderStatus translated use locale index li
+func _OrderStatus_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _OrderStatus_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _OrderStatus_transArg translate arg use locale index li when arg is a value of OrderStatus, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _OrderStatus_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case OrderStatus:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_OrderStatus_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _OrderStatus_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _OrderStatus_sprintf
+func _OrderStatus_appendf(dst []byte, msg string, segs []_OrderStatus_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case OrderStatus:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_OrderStatus_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _OrderStatus_sprintf(msg, li, args)...)
+}
+
+// _OrderCode_pool deduplicated texts shared by all types and locales in this file
+const _OrderCode_pool = "order not foundorder already paid订单不存在订单已支付closedopen已关闭进行中"
+
+// _OrderCode_pool_index boundaries of each text in _OrderCode_pool
+var _OrderCode_pool_index = [...]uint8{0, 15, 33, 48, 63, 69, 73, 82, 91}
+
+// _OrderCode_poolText get text by id from _OrderCode_pool
+func _OrderCode_poolText(id int) string {
+	return _OrderCode_pool[_OrderCode_pool_index[id]:_OrderCode_pool_index[id+1]]
+}
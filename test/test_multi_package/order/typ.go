@@ -0,0 +1,14 @@
+package order
+
+type OrderCode int
+type OrderStatus string
+
+const (
+	OrderNotFound OrderCode = iota + 1
+	OrderPaid
+)
+
+const (
+	OrderStatusOpen   OrderStatus = "open"
+	OrderStatusClosed OrderStatus = "closed"
+)
@@ -0,0 +1,8 @@
+package user
+
+type UserCode int
+
+const (
+	UserNotFound UserCode = iota + 1
+	UserDisabled
+)
@@ -0,0 +1,410 @@
+// Code generated by "i18n-stringer -type UserCode,OrderCode,OrderStatus -defaultlocale en ./..."; DO NOT EDIT.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[UserNotFound-1]
+	_ = x[UserDisabled-2]
+}
+
+var (
+	_UserCode_name  = [...]string{"user not founduser disabled", "用户不存在用户已禁用"}
+	_UserCode_index = [...][3]uint8{{0, 14, 27}, {0, 15, 30}}
+)
+
+// _transIdx translate one CONST with locale index
+func (i UserCode) _transIdx(li int) string {
+	i -= 1
+	if i < 0 || i >= UserCode(len(_UserCode_index[0])-1) {
+		return "UserCode[" + _UserCode_locales[li] + "](" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _UserCode_name[li][_UserCode_index[li][i]:_UserCode_index[li][i+1]]
+}
+
+// _UserCode_locales All supported locales indexed by value of _UserCode_supported
+var _UserCode_locales = []string{"en", "zh-cn"}
+
+// _UserCode_supported All supported locales record, locale to index of _UserCode_locales
+var _UserCode_supported = map[string]int{"en": 0, "zh-cn": 1}
+
+// _UserCode_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _UserCode_defaultLocale = "en"
+
+// _UserCode_defaultIdx index of default locale in _UserCode_locales
+var _UserCode_defaultIdx = _UserCode_supported[_UserCode_defaultLocale]
+
+// _UserCode_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _UserCode_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i UserCode) String() string {
+	return i._trans(_UserCode_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i UserCode) Error() string {
+	return i._trans(_UserCode_defaultIdx)
+}
+
+// Code get original type int value
+func (i UserCode) Code() int {
+	return int(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i UserCode) Wrap(err error, locale string, args ...interface{}) *I18nUserCodeErrorWrap {
+	i._mustNotFragment()
+	return &I18nUserCodeErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _UserCode_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i UserCode) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nUserCodeErrorWrap {
+	i._mustNotFragment()
+	return &I18nUserCodeErrorWrap{err: err, origin: i, locale: _UserCode_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i UserCode) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("UserCode(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nUserCodeErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nUserCodeErrorWrap struct {
+	err    error         // wrap another error
+	origin UserCode      // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nUserCodeErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nUserCodeErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nUserCodeErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nUserCodeErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nUserCodeErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nUserCodeErrorWrap) Value() UserCode {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nUserCodeErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i UserCode) IsLocaleSupport(locale string) bool {
+	return _UserCode_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _UserCode_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i UserCode) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_UserCode_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i UserCode) Trans(locale string, args ...interface{}) string {
+	return i._trans(_UserCode_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i UserCode) LocaleIndex(locale string) int {
+	return _UserCode_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i UserCode) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_UserCode_locales) {
+		li = _UserCode_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _UserCode_isLocaleSupport(locale string) bool {
+	return _UserCode_lookupLocale(locale) >= 0
+}
+
+// _UserCode_lookupLocale resolve language locale name to index of _UserCode_locales, -1 when it is not supported
+func _UserCode_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-cn":
+		return 1
+	}
+	return -1
+}
+
+// _UserCode_localeIdx resolve language locale name to index of _UserCode_locales.
+// It returns index of default locale when _UserCode_isLocaleSupport is false
+func _UserCode_localeIdx(locale string) int {
+	if li := _UserCode_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _UserCode_defaultIdx
+}
+
+// _UserCode_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _UserCode_locales.
+// It returns index of default locale when _UserCode_isLocaleSupport is false
+func _UserCode_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _UserCode_defaultIdx
+	}
+	if v, ok := ctx.Value(_UserCode_ctxKey).(string); ok {
+		return _UserCode_localeIdx(v)
+	}
+	return _UserCode_defaultIdx
+}
+
+// _UserCode_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _UserCode_isLocaleSupport is false
+func _UserCode_localeFromCtxWithFallback(ctx context.Context) string {
+	return _UserCode_locales[_UserCode_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i UserCode) IsFragment() bool {
+	return false
+}
+
+// _UserCode_hasVerbs whether any translation of UserCode has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _UserCode_hasVerbs = false
+
+// _UserCode_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _UserCode_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _UserCode_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _UserCode_fmt = [][][]_UserCode_seg{
+	{}, // en
+	{}, // zh-cn
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i UserCode) _segs(li int) []_UserCode_seg {
+	return nil
+}
+
+// _UserCode_bufPool buffers formatting translations with args
+var _UserCode_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _UserCode_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i UserCode) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i UserCode) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_UserCode_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _UserCode_bufPool.Get().(*[]byte)
+	*buf = _UserCode_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_UserCode_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i UserCode) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _UserCode_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_UserCode_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _UserCode_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i UserCode) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _UserCode_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_UserCode_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _UserCode_bufPool.Get().(*[]byte)
+	*buf = _UserCode_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_UserCode_bufPool.Put(buf)
+	return n, err
+}
+
+// _UserCode_sprintf format msg with args, args of type UserCode translated use locale index li
+func _UserCode_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _UserCode_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _UserCode_transArg translate arg use locale index li when arg is a value of UserCode, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _UserCode_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case UserCode:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_UserCode_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _UserCode_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _UserCode_sprintf
+func _UserCode_appendf(dst []byte, msg string, segs []_UserCode_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case UserCode:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_UserCode_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _UserCode_sprintf(msg, li, args)...)
+}
@@ -5,7 +5,9 @@ package test_no_export
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
+	"sync"
 )
 
 func _() {
@@ -14,43 +16,36 @@ func _() {
 	var x [1]struct{}
 	_ = x[HELLO-1]
 	_ = x[WORLD-2]
+	_ = x[ALIAS-2]
 }
 
-const (
-	_code_no_export_En_name   = "Helloworld"
-	_code_no_export_ZhHk_name = "你好世界"
-)
-
 var (
-	_code_no_export_En_index   = [...]uint8{0, 5, 10}
-	_code_no_export_ZhHk_index = [...]uint8{0, 6, 12}
+	_code_no_export_name  = [...]string{"Helloworld", "你好世界"}
+	_code_no_export_index = [...][3]uint8{{0, 5, 10}, {0, 6, 12}}
 )
 
-// _transOne translate one CONST
-func (i code_no_export) _transOne(locale string) string {
+// _transIdx translate one CONST with locale index
+func (i code_no_export) _transIdx(li int) string {
 	i -= 1
-	if i >= code_no_export(len(_code_no_export_En_index)-1) {
-		return "code_no_export[" + locale + "](" + strconv.FormatInt(int64(i), 10) + ")"
-	}
-
-	switch locale {
-	case "en":
-		return _code_no_export_En_name[_code_no_export_En_index[i]:_code_no_export_En_index[i+1]]
-	case "zh-hk":
-		return _code_no_export_ZhHk_name[_code_no_export_ZhHk_index[i]:_code_no_export_ZhHk_index[i+1]]
-	default:
-		// Normally unreachable, should not happen but be cautious
-		return ""
+	if i >= code_no_export(len(_code_no_export_index[0])-1) {
+		return "code_no_export[" + _code_no_export_locales[li] + "](" + strconv.FormatInt(int64(i+1), 10) + ")"
 	}
+	return _code_no_export_name[li][_code_no_export_index[li][i]:_code_no_export_index[li][i+1]]
 }
 
-// _code_no_export_supported All supported locales record
+// _code_no_export_locales All supported locales indexed by value of _code_no_export_supported
+var _code_no_export_locales = []string{"en", "zh-hk"}
+
+// _code_no_export_supported All supported locales record, locale to index of _code_no_export_locales
 var _code_no_export_supported = map[string]int{"en": 0, "zh-hk": 1}
 
 // _code_no_export_defaultLocale default locale
 // generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
 var _code_no_export_defaultLocale = "en"
 
+// _code_no_export_defaultIdx index of default locale in _code_no_export_locales
+var _code_no_export_defaultIdx = _code_no_export_supported[_code_no_export_defaultLocale]
+
 // _code_no_export_ctxKey Key from context.Context Value get locale
 // generated pass by i18n-stringer flag -ctxkey, Don't assign directly
 var _code_no_export_ctxKey = "i18nLocale"
@@ -61,7 +56,7 @@ var _code_no_export_ctxKey = "i18nLocale"
 //   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
 //   - If you understand the above mechanism then you can use this method with confidence
 func (i code_no_export) String() string {
-	return i._trans(_code_no_export_defaultLocale)
+	return i._trans(_code_no_export_defaultIdx)
 }
 
 // WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
@@ -70,7 +65,7 @@ func (i code_no_export) String() string {
 //   - This method implements the error interface, so that you can return the value as an error,
 //   - If you understand the above mechanism then you can use this method with confidence
 func (i code_no_export) Error() string {
-	return i._trans(_code_no_export_defaultLocale)
+	return i._trans(_code_no_export_defaultIdx)
 }
 
 // Code get original type uint8 value
@@ -78,22 +73,31 @@ func (i code_no_export) Code() uint8 {
 	return uint8(i)
 }
 
-// Wrap another error with locale set for i18n TYPE Const
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
 //   - err another error
 //   - locale i18n locale name
 //   - args optional formatting component
 func (i code_no_export) Wrap(err error, locale string, args ...interface{}) *I18nCodeNoExportErrorWrap {
+	i._mustNotFragment()
 	return &I18nCodeNoExportErrorWrap{err: err, origin: i, locale: locale, args: args}
 }
 
-// WrapWithContext wrap another error with context.Context set for i18n TYPE Const
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
 //   - ctx context with Value use Key from _code_no_export_ctxKey, which pass by i18n-stringer flag -ctxkey
 //   - err another error
 //   - args optional formatting component
 func (i code_no_export) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nCodeNoExportErrorWrap {
+	i._mustNotFragment()
 	return &I18nCodeNoExportErrorWrap{err: err, origin: i, locale: _code_no_export_localeFromCtxWithFallback(ctx), args: args}
 }
 
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i code_no_export) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("code_no_export(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
 // I18nCodeNoExportErrorWrap type i18n error wrapper
 //
 //	WARNING
@@ -112,6 +116,17 @@ func (e *I18nCodeNoExportErrorWrap) Translate() string {
 	return e.origin.Trans(e.locale, e.args...)
 }
 
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nCodeNoExportErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
 // String implement fmt.Stringer, get translated string use Translate
 func (e *I18nCodeNoExportErrorWrap) String() string {
 	return e.Translate()
@@ -153,57 +168,244 @@ func (i code_no_export) IsLocaleSupport(locale string) bool {
 
 // Lang get target translate text use context.Context
 //   - ctx  context with Value use Key from _code_no_export_ctxKey, which pass by i18n-stringer flag -ctxkey
-//   - args Optional placeholder replacement value, value type of code_no_export, or type of string
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
 func (i code_no_export) Lang(ctx context.Context, args ...interface{}) string {
-	return i._trans(_code_no_export_localeFromCtxWithFallback(ctx), args...)
+	return i._trans(_code_no_export_localeIdxFromCtx(ctx), args...)
 }
 
 // Trans get target translate text use specified language locale identifier
 //   - locale specified language locale identifier, need pass by IsLocaleSupport
-//   - args Optional placeholder replacement value, value type of code_no_export, or type of string
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
 func (i code_no_export) Trans(locale string, args ...interface{}) string {
-	if !_code_no_export_isLocaleSupport(locale) {
-		locale = _code_no_export_defaultLocale
+	return i._trans(_code_no_export_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i code_no_export) LocaleIndex(locale string) int {
+	return _code_no_export_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i code_no_export) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_code_no_export_locales) {
+		li = _code_no_export_defaultIdx
 	}
-	return i._trans(locale, args...)
+	return i._trans(li, args...)
 }
 
 func _code_no_export_isLocaleSupport(locale string) bool {
-	_, ok := _code_no_export_supported[locale]
-	return ok
+	return _code_no_export_lookupLocale(locale) >= 0
+}
+
+// _code_no_export_lookupLocale resolve language locale name to index of _code_no_export_locales, -1 when it is not supported
+func _code_no_export_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-hk":
+		return 1
+	}
+	return -1
+}
+
+// _code_no_export_localeIdx resolve language locale name to index of _code_no_export_locales.
+// It returns index of default locale when _code_no_export_isLocaleSupport is false
+func _code_no_export_localeIdx(locale string) int {
+	if li := _code_no_export_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _code_no_export_defaultIdx
+}
+
+// _code_no_export_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _code_no_export_locales.
+// It returns index of default locale when _code_no_export_isLocaleSupport is false
+func _code_no_export_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _code_no_export_defaultIdx
+	}
+	if v, ok := ctx.Value(_code_no_export_ctxKey).(string); ok {
+		return _code_no_export_localeIdx(v)
+	}
+	return _code_no_export_defaultIdx
 }
 
 // _code_no_export_localeFromCtxWithFallback retrieves and returns language locale name from context.
 // It returns default locale when _code_no_export_isLocaleSupport is false
 func _code_no_export_localeFromCtxWithFallback(ctx context.Context) string {
-	if ctx == nil {
-		return _code_no_export_defaultLocale
+	return _code_no_export_locales[_code_no_export_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i code_no_export) IsFragment() bool {
+	return false
+}
+
+// _code_no_export_hasVerbs whether any translation of code_no_export has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _code_no_export_hasVerbs = false
+
+// _code_no_export_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _code_no_export_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _code_no_export_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _code_no_export_fmt = [][][]_code_no_export_seg{
+	{}, // en
+	{}, // zh-hk
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i code_no_export) _segs(li int) []_code_no_export_seg {
+	return nil
+}
+
+// _code_no_export_bufPool buffers formatting translations with args
+var _code_no_export_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _code_no_export_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i code_no_export) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i code_no_export) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_code_no_export_hasVerbs {
+		return msg
 	}
-	v := ctx.Value(_code_no_export_ctxKey)
-	if v == nil {
-		return _code_no_export_defaultLocale
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
 	}
-	if vv, ok := v.(string); ok && _code_no_export_isLocaleSupport(vv) {
-		return vv
+	buf := _code_no_export_bufPool.Get().(*[]byte)
+	*buf = _code_no_export_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_code_no_export_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i code_no_export) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _code_no_export_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_code_no_export_hasVerbs {
+		return append(dst, msg...)
 	}
-	return _code_no_export_defaultLocale
+	if segs := i._segs(li); segs != nil {
+		return _code_no_export_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
 }
 
-// _trans trustworthy parameters inside method
-//   - locale i18n local
-//   - args   value type of code_no_export, or type of string
-func (i code_no_export) _trans(locale string, args ...interface{}) string {
-	msg := i._transOne(locale)
-	if len(args) > 0 {
-		var com []interface{}
-		for _, arg := range args {
-			if typ, ok := arg.(code_no_export); ok {
-				com = append(com, typ._transOne(locale))
-			} else {
-				com = append(com, arg) // arg as string scalar
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i code_no_export) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _code_no_export_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_code_no_export_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _code_no_export_bufPool.Get().(*[]byte)
+	*buf = _code_no_export_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_code_no_export_bufPool.Put(buf)
+	return n, err
+}
+
+// _code_no_export_sprintf format msg with args, args of type code_no_export translated use locale index li
+func _code_no_export_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _code_no_export_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _code_no_export_transArg translate arg use locale index li when arg is a value of code_no_export, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _code_no_export_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case code_no_export:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_code_no_export_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _code_no_export_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _code_no_export_sprintf
+func _code_no_export_appendf(dst []byte, msg string, segs []_code_no_export_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case code_no_export:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
 			}
+			dst = append(dst, arg.Trans(_code_no_export_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
 		}
-		return fmt.Sprintf(msg, com...)
+		n++
 	}
-	return msg
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _code_no_export_sprintf(msg, li, args)...)
 }
@@ -0,0 +1,1274 @@
+// Code generated by "i18n-stringer -type RuneOne,RuneMulti,RuneMap -splitlocales"; DO NOT EDIT.
+
+package test_split_locales
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[CostRuneOT1-20]
+	_ = x[CostRuneOT2-21]
+	_ = x[CostRuneOT3-22]
+}
+
+var (
+	_RuneOne_En_ids = [...][3]uint8{{0, 1, 2}}
+)
+
+// _transIdxEn translate one CONST with locale index
+func (i RuneOne) _transIdxEn(li int) string {
+	i -= 20
+	if i < 0 || i >= RuneOne(len(_RuneOne_En_ids[0])) {
+		return "RuneOne[" + _RuneOne_locales[li] + "](" + strconv.FormatInt(int64(i+20), 10) + ")"
+	}
+	return _RuneOne_poolText(int(_RuneOne_En_ids[0][i]))
+}
+
+// _RuneOne_transLocale translate one CONST method of each supported locale indexed by _RuneOne_supported,
+// locales in split files built with their build tag register themselves at init time
+var _RuneOne_transLocale = []func(RuneOne, int) string{RuneOne._transIdxEn}
+
+// _transIdx translate one CONST with locale index
+func (i RuneOne) _transIdx(li int) string {
+	return _RuneOne_transLocale[li](i, li)
+}
+
+// _RuneOne_locales All supported locales indexed by value of _RuneOne_supported
+var _RuneOne_locales = []string{"en"}
+
+// _RuneOne_supported All supported locales record, locale to index of _RuneOne_locales
+var _RuneOne_supported = map[string]int{"en": 0}
+
+// _RuneOne_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _RuneOne_defaultLocale = "en"
+
+// _RuneOne_defaultIdx index of default locale in _RuneOne_locales
+var _RuneOne_defaultIdx = _RuneOne_supported[_RuneOne_defaultLocale]
+
+// _RuneOne_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _RuneOne_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i RuneOne) String() string {
+	return i._trans(_RuneOne_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i RuneOne) Error() string {
+	return i._trans(_RuneOne_defaultIdx)
+}
+
+// Code get original type int value
+func (i RuneOne) Code() int {
+	return int(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i RuneOne) Wrap(err error, locale string, args ...interface{}) *I18nRuneOneErrorWrap {
+	i._mustNotFragment()
+	return &I18nRuneOneErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _RuneOne_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i RuneOne) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nRuneOneErrorWrap {
+	i._mustNotFragment()
+	return &I18nRuneOneErrorWrap{err: err, origin: i, locale: _RuneOne_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i RuneOne) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("RuneOne(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nRuneOneErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nRuneOneErrorWrap struct {
+	err    error         // wrap another error
+	origin RuneOne       // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nRuneOneErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nRuneOneErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nRuneOneErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nRuneOneErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nRuneOneErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nRuneOneErrorWrap) Value() RuneOne {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nRuneOneErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i RuneOne) IsLocaleSupport(locale string) bool {
+	return _RuneOne_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _RuneOne_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneOne) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_RuneOne_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneOne) Trans(locale string, args ...interface{}) string {
+	return i._trans(_RuneOne_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i RuneOne) LocaleIndex(locale string) int {
+	return _RuneOne_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneOne) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_RuneOne_locales) {
+		li = _RuneOne_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _RuneOne_isLocaleSupport(locale string) bool {
+	return _RuneOne_lookupLocale(locale) >= 0
+}
+
+// _RuneOne_lookupLocale resolve language locale name to index of _RuneOne_locales, -1 when it is not supported
+func _RuneOne_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	}
+	if li, ok := _RuneOne_supported[locale]; ok {
+		return li
+	}
+	return -1
+}
+
+// _RuneOne_localeIdx resolve language locale name to index of _RuneOne_locales.
+// It returns index of default locale when _RuneOne_isLocaleSupport is false
+func _RuneOne_localeIdx(locale string) int {
+	if li := _RuneOne_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _RuneOne_defaultIdx
+}
+
+// _RuneOne_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _RuneOne_locales.
+// It returns index of default locale when _RuneOne_isLocaleSupport is false
+func _RuneOne_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _RuneOne_defaultIdx
+	}
+	if v, ok := ctx.Value(_RuneOne_ctxKey).(string); ok {
+		return _RuneOne_localeIdx(v)
+	}
+	return _RuneOne_defaultIdx
+}
+
+// _RuneOne_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _RuneOne_isLocaleSupport is false
+func _RuneOne_localeFromCtxWithFallback(ctx context.Context) string {
+	return _RuneOne_locales[_RuneOne_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i RuneOne) IsFragment() bool {
+	return false
+}
+
+// _RuneOne_hasVerbs whether any translation of RuneOne has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _RuneOne_hasVerbs = false
+
+// _RuneOne_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _RuneOne_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _RuneOne_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _RuneOne_fmt = [][][]_RuneOne_seg{
+	{}, // en
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i RuneOne) _segs(li int) []_RuneOne_seg {
+	return nil
+}
+
+// _RuneOne_bufPool buffers formatting translations with args
+var _RuneOne_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _RuneOne_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i RuneOne) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i RuneOne) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_RuneOne_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _RuneOne_bufPool.Get().(*[]byte)
+	*buf = _RuneOne_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_RuneOne_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneOne) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _RuneOne_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_RuneOne_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _RuneOne_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneOne) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _RuneOne_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_RuneOne_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _RuneOne_bufPool.Get().(*[]byte)
+	*buf = _RuneOne_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_RuneOne_bufPool.Put(buf)
+	return n, err
+}
+
+// _RuneOne_sprintf format msg with args, args of type RuneOne translated use locale index li
+func _RuneOne_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _RuneOne_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _RuneOne_transArg translate arg use locale index li when arg is a value of RuneOne, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _RuneOne_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case RuneOne:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_RuneOne_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _RuneOne_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _RuneOne_sprintf
+func _RuneOne_appendf(dst []byte, msg string, segs []_RuneOne_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case RuneOne:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_RuneOne_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _RuneOne_sprintf(msg, li, args)...)
+}
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[CostRuneMT1-1]
+	_ = x[CostRuneMT2-2]
+	_ = x[CostRuneMT3-3]
+	_ = x[CostRuneMT4-1003]
+	_ = x[CostRuneMT5-1004]
+}
+
+var (
+	_RuneMulti_En_ids_0 = [...][3]uint8{{3, 4, 5}}
+	_RuneMulti_En_ids_1 = [...][2]uint8{{6, 7}}
+)
+
+// _transIdxEn translate one CONST with locale index
+func (i RuneMulti) _transIdxEn(li int) string {
+	switch {
+	case 1 <= i && i <= 3:
+		i -= 1
+		return _RuneOne_poolText(int(_RuneMulti_En_ids_0[0][i]))
+	case 1003 <= i && i <= 1004:
+		i -= 1003
+		return _RuneOne_poolText(int(_RuneMulti_En_ids_1[0][i]))
+	default:
+		return "RuneMulti[" + _RuneMulti_locales[li] + "](" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// _RuneMulti_transLocale translate one CONST method of each supported locale indexed by _RuneMulti_supported,
+// locales in split files built with their build tag register themselves at init time
+var _RuneMulti_transLocale = []func(RuneMulti, int) string{RuneMulti._transIdxEn}
+
+// _transIdx translate one CONST with locale index
+func (i RuneMulti) _transIdx(li int) string {
+	return _RuneMulti_transLocale[li](i, li)
+}
+
+// _RuneMulti_locales All supported locales indexed by value of _RuneMulti_supported
+var _RuneMulti_locales = []string{"en"}
+
+// _RuneMulti_supported All supported locales record, locale to index of _RuneMulti_locales
+var _RuneMulti_supported = map[string]int{"en": 0}
+
+// _RuneMulti_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _RuneMulti_defaultLocale = "en"
+
+// _RuneMulti_defaultIdx index of default locale in _RuneMulti_locales
+var _RuneMulti_defaultIdx = _RuneMulti_supported[_RuneMulti_defaultLocale]
+
+// _RuneMulti_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _RuneMulti_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i RuneMulti) String() string {
+	return i._trans(_RuneMulti_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i RuneMulti) Error() string {
+	return i._trans(_RuneMulti_defaultIdx)
+}
+
+// Code get original type int value
+func (i RuneMulti) Code() int {
+	return int(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i RuneMulti) Wrap(err error, locale string, args ...interface{}) *I18nRuneMultiErrorWrap {
+	i._mustNotFragment()
+	return &I18nRuneMultiErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _RuneMulti_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i RuneMulti) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nRuneMultiErrorWrap {
+	i._mustNotFragment()
+	return &I18nRuneMultiErrorWrap{err: err, origin: i, locale: _RuneMulti_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i RuneMulti) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("RuneMulti(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nRuneMultiErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nRuneMultiErrorWrap struct {
+	err    error         // wrap another error
+	origin RuneMulti     // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nRuneMultiErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nRuneMultiErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nRuneMultiErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nRuneMultiErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nRuneMultiErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nRuneMultiErrorWrap) Value() RuneMulti {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nRuneMultiErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i RuneMulti) IsLocaleSupport(locale string) bool {
+	return _RuneMulti_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _RuneMulti_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMulti) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_RuneMulti_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMulti) Trans(locale string, args ...interface{}) string {
+	return i._trans(_RuneMulti_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i RuneMulti) LocaleIndex(locale string) int {
+	return _RuneMulti_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMulti) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_RuneMulti_locales) {
+		li = _RuneMulti_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _RuneMulti_isLocaleSupport(locale string) bool {
+	return _RuneMulti_lookupLocale(locale) >= 0
+}
+
+// _RuneMulti_lookupLocale resolve language locale name to index of _RuneMulti_locales, -1 when it is not supported
+func _RuneMulti_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	}
+	if li, ok := _RuneMulti_supported[locale]; ok {
+		return li
+	}
+	return -1
+}
+
+// _RuneMulti_localeIdx resolve language locale name to index of _RuneMulti_locales.
+// It returns index of default locale when _RuneMulti_isLocaleSupport is false
+func _RuneMulti_localeIdx(locale string) int {
+	if li := _RuneMulti_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _RuneMulti_defaultIdx
+}
+
+// _RuneMulti_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _RuneMulti_locales.
+// It returns index of default locale when _RuneMulti_isLocaleSupport is false
+func _RuneMulti_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _RuneMulti_defaultIdx
+	}
+	if v, ok := ctx.Value(_RuneMulti_ctxKey).(string); ok {
+		return _RuneMulti_localeIdx(v)
+	}
+	return _RuneMulti_defaultIdx
+}
+
+// _RuneMulti_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _RuneMulti_isLocaleSupport is false
+func _RuneMulti_localeFromCtxWithFallback(ctx context.Context) string {
+	return _RuneMulti_locales[_RuneMulti_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i RuneMulti) IsFragment() bool {
+	return false
+}
+
+// _RuneMulti_hasVerbs whether any translation of RuneMulti has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _RuneMulti_hasVerbs = false
+
+// _RuneMulti_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _RuneMulti_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _RuneMulti_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _RuneMulti_fmt = [][][]_RuneMulti_seg{
+	{}, // en
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i RuneMulti) _segs(li int) []_RuneMulti_seg {
+	return nil
+}
+
+// _RuneMulti_bufPool buffers formatting translations with args
+var _RuneMulti_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _RuneMulti_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i RuneMulti) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i RuneMulti) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_RuneMulti_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _RuneMulti_bufPool.Get().(*[]byte)
+	*buf = _RuneMulti_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_RuneMulti_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMulti) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _RuneMulti_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_RuneMulti_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _RuneMulti_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMulti) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _RuneMulti_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_RuneMulti_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _RuneMulti_bufPool.Get().(*[]byte)
+	*buf = _RuneMulti_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_RuneMulti_bufPool.Put(buf)
+	return n, err
+}
+
+// _RuneMulti_sprintf format msg with args, args of type RuneMulti translated use locale index li
+func _RuneMulti_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _RuneMulti_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _RuneMulti_transArg translate arg use locale index li when arg is a value of RuneMulti, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _RuneMulti_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case RuneMulti:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_RuneMulti_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _RuneMulti_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _RuneMulti_sprintf
+func _RuneMulti_appendf(dst []byte, msg string, segs []_RuneMulti_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case RuneMulti:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_RuneMulti_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _RuneMulti_sprintf(msg, li, args)...)
+}
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ConstRuneMaT1-1000]
+	_ = x[ConstRuneMaT2-2000]
+	_ = x[ConstRuneMaT3-3000]
+	_ = x[ConstRuneMaT4-4000]
+	_ = x[ConstRuneMaT5-5000]
+	_ = x[ConstRuneMaT6-6000]
+	_ = x[ConstRuneMaT7-7000]
+	_ = x[ConstRuneMaT8-8000]
+	_ = x[ConstRuneMaT9-9000]
+	_ = x[ConstRuneMaT10-10000]
+	_ = x[ConstRuneMaT11-11000]
+}
+
+var (
+	_RuneMap_En_ids = [...][11]uint8{{8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}}
+	_RuneMap_En_map = map[RuneMap]uint8{
+		1000:  0,
+		2000:  1,
+		3000:  2,
+		4000:  3,
+		5000:  4,
+		6000:  5,
+		7000:  6,
+		8000:  7,
+		9000:  8,
+		10000: 9,
+		11000: 10,
+	}
+)
+
+// _transIdxEn translate one CONST with locale index
+func (i RuneMap) _transIdxEn(li int) string {
+	if n, ok := _RuneMap_En_map[i]; ok {
+		return _RuneOne_poolText(int(_RuneMap_En_ids[0][n]))
+	}
+	return "RuneMap[" + _RuneMap_locales[li] + "](" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+// _RuneMap_transLocale translate one CONST method of each supported locale indexed by _RuneMap_supported,
+// locales in split files built with their build tag register themselves at init time
+var _RuneMap_transLocale = []func(RuneMap, int) string{RuneMap._transIdxEn}
+
+// _transIdx translate one CONST with locale index
+func (i RuneMap) _transIdx(li int) string {
+	return _RuneMap_transLocale[li](i, li)
+}
+
+// _RuneMap_locales All supported locales indexed by value of _RuneMap_supported
+var _RuneMap_locales = []string{"en"}
+
+// _RuneMap_supported All supported locales record, locale to index of _RuneMap_locales
+var _RuneMap_supported = map[string]int{"en": 0}
+
+// _RuneMap_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _RuneMap_defaultLocale = "en"
+
+// _RuneMap_defaultIdx index of default locale in _RuneMap_locales
+var _RuneMap_defaultIdx = _RuneMap_supported[_RuneMap_defaultLocale]
+
+// _RuneMap_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _RuneMap_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i RuneMap) String() string {
+	return i._trans(_RuneMap_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i RuneMap) Error() string {
+	return i._trans(_RuneMap_defaultIdx)
+}
+
+// Code get original type int value
+func (i RuneMap) Code() int {
+	return int(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i RuneMap) Wrap(err error, locale string, args ...interface{}) *I18nRuneMapErrorWrap {
+	i._mustNotFragment()
+	return &I18nRuneMapErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _RuneMap_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i RuneMap) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nRuneMapErrorWrap {
+	i._mustNotFragment()
+	return &I18nRuneMapErrorWrap{err: err, origin: i, locale: _RuneMap_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i RuneMap) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("RuneMap(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nRuneMapErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nRuneMapErrorWrap struct {
+	err    error         // wrap another error
+	origin RuneMap       // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nRuneMapErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nRuneMapErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nRuneMapErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nRuneMapErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nRuneMapErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nRuneMapErrorWrap) Value() RuneMap {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nRuneMapErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i RuneMap) IsLocaleSupport(locale string) bool {
+	return _RuneMap_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _RuneMap_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMap) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_RuneMap_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMap) Trans(locale string, args ...interface{}) string {
+	return i._trans(_RuneMap_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i RuneMap) LocaleIndex(locale string) int {
+	return _RuneMap_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMap) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_RuneMap_locales) {
+		li = _RuneMap_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _RuneMap_isLocaleSupport(locale string) bool {
+	return _RuneMap_lookupLocale(locale) >= 0
+}
+
+// _RuneMap_lookupLocale resolve language locale name to index of _RuneMap_locales, -1 when it is not supported
+func _RuneMap_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	}
+	if li, ok := _RuneMap_supported[locale]; ok {
+		return li
+	}
+	return -1
+}
+
+// _RuneMap_localeIdx resolve language locale name to index of _RuneMap_locales.
+// It returns index of default locale when _RuneMap_isLocaleSupport is false
+func _RuneMap_localeIdx(locale string) int {
+	if li := _RuneMap_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _RuneMap_defaultIdx
+}
+
+// _RuneMap_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _RuneMap_locales.
+// It returns index of default locale when _RuneMap_isLocaleSupport is false
+func _RuneMap_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _RuneMap_defaultIdx
+	}
+	if v, ok := ctx.Value(_RuneMap_ctxKey).(string); ok {
+		return _RuneMap_localeIdx(v)
+	}
+	return _RuneMap_defaultIdx
+}
+
+// _RuneMap_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _RuneMap_isLocaleSupport is false
+func _RuneMap_localeFromCtxWithFallback(ctx context.Context) string {
+	return _RuneMap_locales[_RuneMap_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i RuneMap) IsFragment() bool {
+	return false
+}
+
+// _RuneMap_hasVerbs whether any translation of RuneMap has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _RuneMap_hasVerbs = false
+
+// _RuneMap_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _RuneMap_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _RuneMap_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _RuneMap_fmt = [][][]_RuneMap_seg{
+	{}, // en
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i RuneMap) _segs(li int) []_RuneMap_seg {
+	return nil
+}
+
+// _RuneMap_bufPool buffers formatting translations with args
+var _RuneMap_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _RuneMap_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i RuneMap) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i RuneMap) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_RuneMap_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _RuneMap_bufPool.Get().(*[]byte)
+	*buf = _RuneMap_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_RuneMap_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMap) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _RuneMap_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_RuneMap_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _RuneMap_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i RuneMap) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _RuneMap_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_RuneMap_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _RuneMap_bufPool.Get().(*[]byte)
+	*buf = _RuneMap_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_RuneMap_bufPool.Put(buf)
+	return n, err
+}
+
+// _RuneMap_sprintf format msg with args, args of type RuneMap translated use locale index li
+func _RuneMap_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _RuneMap_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _RuneMap_transArg translate arg use locale index li when arg is a value of RuneMap, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _RuneMap_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case RuneMap:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_RuneMap_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _RuneMap_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _RuneMap_sprintf
+func _RuneMap_appendf(dst []byte, msg string, segs []_RuneMap_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case RuneMap:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_RuneMap_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _RuneMap_sprintf(msg, li, args)...)
+}
+
+// _RuneOne_pool deduplicated texts shared by all types and locales in this file
+const _RuneOne_pool = "single const onesingle const twosingle const three1muilt rune one2muilt rune two3muilt rune three4muilt rune four5muilt rune five1map 12map 23map 34map 45map 56map 67map 78map 89map 910map 1011map 11"
+
+// _RuneOne_pool_index boundaries of each text in _RuneOne_pool
+var _RuneOne_pool_index = [...]uint8{0, 16, 32, 50, 65, 80, 97, 113, 129, 135, 141, 147, 153, 159, 165, 171, 177, 183, 191, 199}
+
+// _RuneOne_poolText get text by id from _RuneOne_pool
+func _RuneOne_poolText(id int) string {
+	return _RuneOne_pool[_RuneOne_pool_index[id]:_RuneOne_pool_index[id+1]]
+}
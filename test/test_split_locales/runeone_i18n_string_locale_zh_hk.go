@@ -0,0 +1,106 @@
+// Code generated by "i18n-stringer -type RuneOne,RuneMulti,RuneMap -splitlocales"; DO NOT EDIT.
+
+//go:build i18n_zh_hk
+// +build i18n_zh_hk
+
+package test_split_locales
+
+import "strconv"
+
+var (
+	_RuneOne_ZhHk_ids = [...][3]uint8{{0, 1, 2}}
+)
+
+// _transIdxZhHk translate one CONST with locale index
+func (i RuneOne) _transIdxZhHk(li int) string {
+	i -= 20
+	if i < 0 || i >= RuneOne(len(_RuneOne_ZhHk_ids[0])) {
+		return "RuneOne[" + _RuneOne_locales[li] + "](" + strconv.FormatInt(int64(i+20), 10) + ")"
+	}
+	return _RuneOne_ZhHk_poolText(int(_RuneOne_ZhHk_ids[0][i]))
+}
+
+// register locale zh-hk of type RuneOne
+func init() {
+	_RuneOne_supported["zh-hk"] = len(_RuneOne_locales)
+	_RuneOne_locales = append(_RuneOne_locales, "zh-hk")
+	_RuneOne_transLocale = append(_RuneOne_transLocale, RuneOne._transIdxZhHk)
+	_RuneOne_fmt = append(_RuneOne_fmt, [][][]_RuneOne_seg{
+		{}, // zh-hk
+	}...)
+}
+
+var (
+	_RuneMulti_ZhHk_ids_0 = [...][3]uint8{{3, 4, 5}}
+	_RuneMulti_ZhHk_ids_1 = [...][2]uint8{{6, 7}}
+)
+
+// _transIdxZhHk translate one CONST with locale index
+func (i RuneMulti) _transIdxZhHk(li int) string {
+	switch {
+	case 1 <= i && i <= 3:
+		i -= 1
+		return _RuneOne_ZhHk_poolText(int(_RuneMulti_ZhHk_ids_0[0][i]))
+	case 1003 <= i && i <= 1004:
+		i -= 1003
+		return _RuneOne_ZhHk_poolText(int(_RuneMulti_ZhHk_ids_1[0][i]))
+	default:
+		return "RuneMulti[" + _RuneMulti_locales[li] + "](" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// register locale zh-hk of type RuneMulti
+func init() {
+	_RuneMulti_supported["zh-hk"] = len(_RuneMulti_locales)
+	_RuneMulti_locales = append(_RuneMulti_locales, "zh-hk")
+	_RuneMulti_transLocale = append(_RuneMulti_transLocale, RuneMulti._transIdxZhHk)
+	_RuneMulti_fmt = append(_RuneMulti_fmt, [][][]_RuneMulti_seg{
+		{}, // zh-hk
+	}...)
+}
+
+var (
+	_RuneMap_ZhHk_ids = [...][11]uint8{{8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}}
+	_RuneMap_ZhHk_map = map[RuneMap]uint8{
+		1000:  0,
+		2000:  1,
+		3000:  2,
+		4000:  3,
+		5000:  4,
+		6000:  5,
+		7000:  6,
+		8000:  7,
+		9000:  8,
+		10000: 9,
+		11000: 10,
+	}
+)
+
+// _transIdxZhHk translate one CONST with locale index
+func (i RuneMap) _transIdxZhHk(li int) string {
+	if n, ok := _RuneMap_ZhHk_map[i]; ok {
+		return _RuneOne_ZhHk_poolText(int(_RuneMap_ZhHk_ids[0][n]))
+	}
+	return "RuneMap[" + _RuneMap_locales[li] + "](" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+// register locale zh-hk of type RuneMap
+func init() {
+	_RuneMap_supported["zh-hk"] = len(_RuneMap_locales)
+	_RuneMap_locales = append(_RuneMap_locales, "zh-hk")
+	_RuneMap_transLocale = append(_RuneMap_transLocale, RuneMap._transIdxZhHk)
+	_RuneMap_fmt = append(_RuneMap_fmt, [][][]_RuneMap_seg{
+		{}, // zh-hk
+	}...)
+}
+
+// _RuneOne_ZhHk_pool deduplicated texts shared by all types and locales in this file
+const _RuneOne_ZhHk_pool = "单个区间常量1单个区间常量2单个区间常量3多个常量一多个常量二多个常量三多个常量四多个常量五1地图一2地图二3地图三4地图四5地图五6地图六7地图七8地图八9地图九10地图十117地图十一"
+
+// _RuneOne_ZhHk_pool_index boundaries of each text in _RuneOne_ZhHk_pool
+var _RuneOne_ZhHk_pool_index = [...]uint8{0, 19, 38, 57, 72, 87, 102, 117, 132, 142, 152, 162, 172, 182, 192, 202, 212, 222, 233, 248}
+
+// _RuneOne_ZhHk_poolText get text by id from _RuneOne_ZhHk_pool
+func _RuneOne_ZhHk_poolText(id int) string {
+	return _RuneOne_ZhHk_pool[_RuneOne_ZhHk_pool_index[id]:_RuneOne_ZhHk_pool_index[id+1]]
+}
@@ -0,0 +1,829 @@
+// Code generated by "i18n-stringer -type Status,Level -defaultlocale en"; DO NOT EDIT.
+
+package test_string_enum
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[len(StatusActive)-len("active")]
+	_ = x[len(StatusDisabled)-len("disabled")]
+	_ = x[len(StatusPending)-len("pending")]
+	_ = x[len(StatusWaiting)-len("pending")]
+	_ = x[len(StatusLabel)-len("label")]
+}
+
+var (
+	_Status_ids = [...][4]uint8{{0, 1, 2, 3}, {4, 5, 6, 7}}
+	_Status_map = map[Status]uint8{
+		StatusActive:   0,
+		StatusDisabled: 1,
+		StatusLabel:    2,
+		StatusPending:  3,
+	}
+)
+
+// _transIdx translate one CONST with locale index
+func (i Status) _transIdx(li int) string {
+	if n, ok := _Status_map[i]; ok {
+		return _Status_poolText(int(_Status_ids[li][n]))
+	}
+	return "Status[" + _Status_locales[li] + "](" + string(i) + ")"
+}
+
+// _Status_locales All supported locales indexed by value of _Status_supported
+var _Status_locales = []string{"en", "zh-cn"}
+
+// _Status_supported All supported locales record, locale to index of _Status_locales
+var _Status_supported = map[string]int{"en": 0, "zh-cn": 1}
+
+// _Status_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _Status_defaultLocale = "en"
+
+// _Status_defaultIdx index of default locale in _Status_locales
+var _Status_defaultIdx = _Status_supported[_Status_defaultLocale]
+
+// _Status_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _Status_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i Status) String() string {
+	return i._trans(_Status_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i Status) Error() string {
+	return i._trans(_Status_defaultIdx)
+}
+
+// Code get original type string value
+func (i Status) Code() string {
+	return string(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i Status) Wrap(err error, locale string, args ...interface{}) *I18nStatusErrorWrap {
+	i._mustNotFragment()
+	return &I18nStatusErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _Status_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i Status) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nStatusErrorWrap {
+	i._mustNotFragment()
+	return &I18nStatusErrorWrap{err: err, origin: i, locale: _Status_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i Status) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("Status(" + string(i) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nStatusErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nStatusErrorWrap struct {
+	err    error         // wrap another error
+	origin Status        // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nStatusErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nStatusErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nStatusErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nStatusErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nStatusErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nStatusErrorWrap) Value() Status {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nStatusErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i Status) IsLocaleSupport(locale string) bool {
+	return _Status_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _Status_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Status) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_Status_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Status) Trans(locale string, args ...interface{}) string {
+	return i._trans(_Status_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i Status) LocaleIndex(locale string) int {
+	return _Status_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Status) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_Status_locales) {
+		li = _Status_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _Status_isLocaleSupport(locale string) bool {
+	return _Status_lookupLocale(locale) >= 0
+}
+
+// _Status_lookupLocale resolve language locale name to index of _Status_locales, -1 when it is not supported
+func _Status_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-cn":
+		return 1
+	}
+	return -1
+}
+
+// _Status_localeIdx resolve language locale name to index of _Status_locales.
+// It returns index of default locale when _Status_isLocaleSupport is false
+func _Status_localeIdx(locale string) int {
+	if li := _Status_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _Status_defaultIdx
+}
+
+// _Status_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _Status_locales.
+// It returns index of default locale when _Status_isLocaleSupport is false
+func _Status_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _Status_defaultIdx
+	}
+	if v, ok := ctx.Value(_Status_ctxKey).(string); ok {
+		return _Status_localeIdx(v)
+	}
+	return _Status_defaultIdx
+}
+
+// _Status_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _Status_isLocaleSupport is false
+func _Status_localeFromCtxWithFallback(ctx context.Context) string {
+	return _Status_locales[_Status_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i Status) IsFragment() bool {
+	switch i {
+	case StatusLabel:
+		return true
+	}
+	return false
+}
+
+// _Status_hasVerbs whether any translation of Status has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _Status_hasVerbs = false
+
+// _Status_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _Status_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _Status_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _Status_fmt = [][][]_Status_seg{
+	{}, // en
+	{}, // zh-cn
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i Status) _segs(li int) []_Status_seg {
+	return nil
+}
+
+// _Status_bufPool buffers formatting translations with args
+var _Status_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _Status_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i Status) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i Status) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_Status_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _Status_bufPool.Get().(*[]byte)
+	*buf = _Status_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_Status_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Status) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _Status_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Status_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _Status_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Status) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _Status_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Status_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _Status_bufPool.Get().(*[]byte)
+	*buf = _Status_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_Status_bufPool.Put(buf)
+	return n, err
+}
+
+// _Status_sprintf format msg with args, args of type Status translated use locale index li
+func _Status_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _Status_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _Status_transArg translate arg use locale index li when arg is a value of Status, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _Status_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case Status:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_Status_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _Status_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _Status_sprintf
+func _Status_appendf(dst []byte, msg string, segs []_Status_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case Status:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_Status_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _Status_sprintf(msg, li, args)...)
+}
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LevelLow-0]
+	_ = x[LevelHigh-1]
+}
+
+var (
+	_Level_ids = [...][2]uint8{{8, 9}, {10, 11}}
+)
+
+// _transIdx translate one CONST with locale index
+func (i Level) _transIdx(li int) string {
+	if i < 0 || i >= Level(len(_Level_ids[0])) {
+		return "Level[" + _Level_locales[li] + "](" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Status_poolText(int(_Level_ids[li][i]))
+}
+
+// _Level_locales All supported locales indexed by value of _Level_supported
+var _Level_locales = []string{"en", "zh-cn"}
+
+// _Level_supported All supported locales record, locale to index of _Level_locales
+var _Level_supported = map[string]int{"en": 0, "zh-cn": 1}
+
+// _Level_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _Level_defaultLocale = "en"
+
+// _Level_defaultIdx index of default locale in _Level_locales
+var _Level_defaultIdx = _Level_supported[_Level_defaultLocale]
+
+// _Level_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _Level_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i Level) String() string {
+	return i._trans(_Level_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i Level) Error() string {
+	return i._trans(_Level_defaultIdx)
+}
+
+// Code get original type int value
+func (i Level) Code() int {
+	return int(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i Level) Wrap(err error, locale string, args ...interface{}) *I18nLevelErrorWrap {
+	i._mustNotFragment()
+	return &I18nLevelErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _Level_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i Level) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nLevelErrorWrap {
+	i._mustNotFragment()
+	return &I18nLevelErrorWrap{err: err, origin: i, locale: _Level_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i Level) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("Level(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nLevelErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nLevelErrorWrap struct {
+	err    error         // wrap another error
+	origin Level         // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nLevelErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nLevelErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nLevelErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nLevelErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nLevelErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nLevelErrorWrap) Value() Level {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nLevelErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i Level) IsLocaleSupport(locale string) bool {
+	return _Level_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _Level_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Level) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_Level_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Level) Trans(locale string, args ...interface{}) string {
+	return i._trans(_Level_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i Level) LocaleIndex(locale string) int {
+	return _Level_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Level) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_Level_locales) {
+		li = _Level_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _Level_isLocaleSupport(locale string) bool {
+	return _Level_lookupLocale(locale) >= 0
+}
+
+// _Level_lookupLocale resolve language locale name to index of _Level_locales, -1 when it is not supported
+func _Level_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-cn":
+		return 1
+	}
+	return -1
+}
+
+// _Level_localeIdx resolve language locale name to index of _Level_locales.
+// It returns index of default locale when _Level_isLocaleSupport is false
+func _Level_localeIdx(locale string) int {
+	if li := _Level_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _Level_defaultIdx
+}
+
+// _Level_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _Level_locales.
+// It returns index of default locale when _Level_isLocaleSupport is false
+func _Level_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _Level_defaultIdx
+	}
+	if v, ok := ctx.Value(_Level_ctxKey).(string); ok {
+		return _Level_localeIdx(v)
+	}
+	return _Level_defaultIdx
+}
+
+// _Level_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _Level_isLocaleSupport is false
+func _Level_localeFromCtxWithFallback(ctx context.Context) string {
+	return _Level_locales[_Level_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i Level) IsFragment() bool {
+	return false
+}
+
+// _Level_hasVerbs whether any translation of Level has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _Level_hasVerbs = false
+
+// _Level_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _Level_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _Level_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _Level_fmt = [][][]_Level_seg{
+	{}, // en
+	{}, // zh-cn
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i Level) _segs(li int) []_Level_seg {
+	return nil
+}
+
+// _Level_bufPool buffers formatting translations with args
+var _Level_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _Level_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i Level) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i Level) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_Level_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _Level_bufPool.Get().(*[]byte)
+	*buf = _Level_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_Level_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Level) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _Level_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Level_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _Level_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Level) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _Level_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Level_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _Level_bufPool.Get().(*[]byte)
+	*buf = _Level_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_Level_bufPool.Put(buf)
+	return n, err
+}
+
+// _Level_sprintf format msg with args, args of type Level translated use locale index li
+func _Level_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _Level_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _Level_transArg translate arg use locale index li when arg is a value of Level, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _Level_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case Level:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_Level_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _Level_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _Level_sprintf
+func _Level_appendf(dst []byte, msg string, segs []_Level_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case Level:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_Level_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _Level_sprintf(msg, li, args)...)
+}
+
+// _Status_pool deduplicated texts shared by all types and locales in this file
+const _Status_pool = "activedisabledstatuspending启用停用状态待审核lowhigh低高"
+
+// _Status_pool_index boundaries of each text in _Status_pool
+var _Status_pool_index = [...]uint8{0, 6, 14, 20, 27, 33, 39, 45, 54, 57, 61, 64, 67}
+
+// _Status_poolText get text by id from _Status_pool
+func _Status_poolText(id int) string {
+	return _Status_pool[_Status_pool_index[id]:_Status_pool_index[id+1]]
+}
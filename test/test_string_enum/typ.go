@@ -0,0 +1,20 @@
+package test_string_enum
+
+//go:generate $GOPATH/bin/i18n-stringer -type Status,Level -defaultlocale en
+
+type Status string
+type Level int
+
+const (
+	StatusActive   Status = "active"
+	StatusDisabled Status = "disabled"
+	StatusPending  Status = "pending"
+	StatusWaiting         = StatusPending
+	//i18n:fragment
+	StatusLabel Status = "label"
+)
+
+const (
+	LevelLow Level = iota
+	LevelHigh
+)
@@ -0,0 +1,412 @@
+// Code generated by "i18n-stringer -type ErrCode -defaultlocale en -trimprefix ErrCode -linecomment"; DO NOT EDIT.
+
+package test_trimprefix
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the i18n-stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ErrCodeUserNotFound-1]
+	_ = x[ErrCodeUserDisabled-2]
+	_ = x[ErrCodeTokenExpired-3]
+	_ = x[ErrCodeInternal-4]
+}
+
+var (
+	_ErrCode_name  = [...]string{"user not foundaccount disabledtoken expiredinternal error", "用户不存在账号已禁用令牌已过期内部错误"}
+	_ErrCode_index = [...][5]uint8{{0, 14, 30, 43, 57}, {0, 15, 30, 45, 57}}
+)
+
+// _transIdx translate one CONST with locale index
+func (i ErrCode) _transIdx(li int) string {
+	i -= 1
+	if i < 0 || i >= ErrCode(len(_ErrCode_index[0])-1) {
+		return "ErrCode[" + _ErrCode_locales[li] + "](" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _ErrCode_name[li][_ErrCode_index[li][i]:_ErrCode_index[li][i+1]]
+}
+
+// _ErrCode_locales All supported locales indexed by value of _ErrCode_supported
+var _ErrCode_locales = []string{"en", "zh-cn"}
+
+// _ErrCode_supported All supported locales record, locale to index of _ErrCode_locales
+var _ErrCode_supported = map[string]int{"en": 0, "zh-cn": 1}
+
+// _ErrCode_defaultLocale default locale
+// generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
+var _ErrCode_defaultLocale = "en"
+
+// _ErrCode_defaultIdx index of default locale in _ErrCode_locales
+var _ErrCode_defaultIdx = _ErrCode_supported[_ErrCode_defaultLocale]
+
+// _ErrCode_ctxKey Key from context.Context Value get locale
+// generated pass by i18n-stringer flag -ctxkey, Don't assign directly
+var _ErrCode_ctxKey = "i18nLocale"
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method Error.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i ErrCode) String() string {
+	return i._trans(_ErrCode_defaultIdx)
+}
+
+// WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
+//   - You should not use this method in an internationalized language environment, as well as method String.
+//   - Because this method always returns the translation value of the default language.
+//   - This method implements the error interface, so that you can return the value as an error,
+//   - If you understand the above mechanism then you can use this method with confidence
+func (i ErrCode) Error() string {
+	return i._trans(_ErrCode_defaultIdx)
+}
+
+// Code get original type int value
+func (i ErrCode) Code() int {
+	return int(i)
+}
+
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - err another error
+//   - locale i18n locale name
+//   - args optional formatting component
+func (i ErrCode) Wrap(err error, locale string, args ...interface{}) *I18nErrCodeErrorWrap {
+	i._mustNotFragment()
+	return &I18nErrCodeErrorWrap{err: err, origin: i, locale: locale, args: args}
+}
+
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
+//   - ctx context with Value use Key from _ErrCode_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - err another error
+//   - args optional formatting component
+func (i ErrCode) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nErrCodeErrorWrap {
+	i._mustNotFragment()
+	return &I18nErrCodeErrorWrap{err: err, origin: i, locale: _ErrCode_localeFromCtxWithFallback(ctx), args: args}
+}
+
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i ErrCode) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("ErrCode(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
+// I18nErrCodeErrorWrap type i18n error wrapper
+//
+//	WARNING
+//	This struct ONLY used to wrap the CONST generated by the i18n-stringer tool,
+//	Pass easily obtain internationalized translations through Error, String, Translate
+//	WARNING
+type I18nErrCodeErrorWrap struct {
+	err    error         // wrap another error
+	origin ErrCode       // custom shaping type Val
+	locale string        // i18n locale set
+	args   []interface{} // formatted output replacement component
+}
+
+// Translate get translated string
+func (e *I18nErrCodeErrorWrap) Translate() string {
+	return e.origin.Trans(e.locale, e.args...)
+}
+
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nErrCodeErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
+// String implement fmt.Stringer, get translated string use Translate
+func (e *I18nErrCodeErrorWrap) String() string {
+	return e.Translate()
+}
+
+// Error struct as error, get typed message wrap with inside error message
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nErrCodeErrorWrap) Error() string {
+	if e.err == nil {
+		return e.Translate()
+	}
+	return fmt.Sprintf("%s (%s)", e.Translate(), e.err.Error())
+}
+
+// Format alias for method Error
+//   - this method will be formatted wrap error if exist.
+//   - Only for development and debugging, or logging full error message
+//   - if you want to get typed message, please use method String or Translate
+func (e *I18nErrCodeErrorWrap) Format() string {
+	return e.Error()
+}
+
+// Value get original type value
+func (e *I18nErrCodeErrorWrap) Value() ErrCode {
+	return e.origin
+}
+
+// Unwrap an error. Get the error inside
+func (e *I18nErrCodeErrorWrap) Unwrap() error {
+	return e.err
+}
+
+// IsLocaleSupport Check if the specified locale is supported
+func (i ErrCode) IsLocaleSupport(locale string) bool {
+	return _ErrCode_isLocaleSupport(locale)
+}
+
+// Lang get target translate text use context.Context
+//   - ctx  context with Value use Key from _ErrCode_ctxKey, which pass by i18n-stringer flag -ctxkey
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i ErrCode) Lang(ctx context.Context, args ...interface{}) string {
+	return i._trans(_ErrCode_localeIdxFromCtx(ctx), args...)
+}
+
+// Trans get target translate text use specified language locale identifier
+//   - locale specified language locale identifier, need pass by IsLocaleSupport
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i ErrCode) Trans(locale string, args ...interface{}) string {
+	return i._trans(_ErrCode_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i ErrCode) LocaleIndex(locale string) int {
+	return _ErrCode_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i ErrCode) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_ErrCode_locales) {
+		li = _ErrCode_defaultIdx
+	}
+	return i._trans(li, args...)
+}
+
+func _ErrCode_isLocaleSupport(locale string) bool {
+	return _ErrCode_lookupLocale(locale) >= 0
+}
+
+// _ErrCode_lookupLocale resolve language locale name to index of _ErrCode_locales, -1 when it is not supported
+func _ErrCode_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-cn":
+		return 1
+	}
+	return -1
+}
+
+// _ErrCode_localeIdx resolve language locale name to index of _ErrCode_locales.
+// It returns index of default locale when _ErrCode_isLocaleSupport is false
+func _ErrCode_localeIdx(locale string) int {
+	if li := _ErrCode_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _ErrCode_defaultIdx
+}
+
+// _ErrCode_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _ErrCode_locales.
+// It returns index of default locale when _ErrCode_isLocaleSupport is false
+func _ErrCode_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _ErrCode_defaultIdx
+	}
+	if v, ok := ctx.Value(_ErrCode_ctxKey).(string); ok {
+		return _ErrCode_localeIdx(v)
+	}
+	return _ErrCode_defaultIdx
+}
+
+// _ErrCode_localeFromCtxWithFallback retrieves and returns language locale name from context.
+// It returns default locale when _ErrCode_isLocaleSupport is false
+func _ErrCode_localeFromCtxWithFallback(ctx context.Context) string {
+	return _ErrCode_locales[_ErrCode_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i ErrCode) IsFragment() bool {
+	return false
+}
+
+// _ErrCode_hasVerbs whether any translation of ErrCode has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _ErrCode_hasVerbs = false
+
+// _ErrCode_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _ErrCode_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _ErrCode_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _ErrCode_fmt = [][][]_ErrCode_seg{
+	{}, // en
+	{}, // zh-cn
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i ErrCode) _segs(li int) []_ErrCode_seg {
+	return nil
+}
+
+// _ErrCode_bufPool buffers formatting translations with args
+var _ErrCode_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _ErrCode_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i ErrCode) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i ErrCode) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_ErrCode_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _ErrCode_bufPool.Get().(*[]byte)
+	*buf = _ErrCode_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_ErrCode_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i ErrCode) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _ErrCode_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_ErrCode_hasVerbs {
+		return append(dst, msg...)
+	}
+	if segs := i._segs(li); segs != nil {
+		return _ErrCode_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i ErrCode) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _ErrCode_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_ErrCode_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _ErrCode_bufPool.Get().(*[]byte)
+	*buf = _ErrCode_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_ErrCode_bufPool.Put(buf)
+	return n, err
+}
+
+// _ErrCode_sprintf format msg with args, args of type ErrCode translated use locale index li
+func _ErrCode_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _ErrCode_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _ErrCode_transArg translate arg use locale index li when arg is a value of ErrCode, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _ErrCode_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case ErrCode:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_ErrCode_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _ErrCode_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _ErrCode_sprintf
+func _ErrCode_appendf(dst []byte, msg string, segs []_ErrCode_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case ErrCode:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_ErrCode_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
+			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
+		}
+		n++
+	}
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _ErrCode_sprintf(msg, li, args)...)
+}
@@ -0,0 +1,12 @@
+package test_trimprefix
+
+//go:generate $GOPATH/bin/i18n-stringer -type ErrCode -defaultlocale en -trimprefix ErrCode -linecomment
+
+type ErrCode int
+
+const (
+	ErrCodeUserNotFound ErrCode = iota + 1 // user not found
+	ErrCodeUserDisabled                    // user disabled
+	ErrCodeTokenExpired                    // token expired
+	ErrCodeInternal                        //i18n:key=ErrCodeInternal
+)
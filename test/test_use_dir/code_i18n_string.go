@@ -5,7 +5,9 @@ package test_use_dir
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
+	"sync"
 )
 
 func _() {
@@ -51,114 +53,68 @@ func _() {
 	_ = x[CodeXe3-20491]
 }
 
-const (
-	_Code_En_name   = "CodeOKCodeErrCodeFailCodeRange1CodeRange2CodeRange3CodeRange4CodeRange5CodeRange6CodeRange7CodeRange9CodeRange10CodeTe1CodeTe2CodeSe1CodeSe2CodeSe3CodeSe4CodeAe1CodeAe2CodeBe1CodeBe2CodeCe1CodeCe2CodeDe1CodeDe2CodeEe1CodeEe2CodeFe1CodeFe2CodeFe3CodeGe1CodeGe2CodeXe1CodeXe2"
-	_Code_ZhHk_name = "CodeOKCodeErrCodeFailCodeRange1CodeRange2CodeRange3CodeRange4CodeRange5CodeRange6CodeRange7CodeRange9CodeRange10CodeTe1CodeTe2CodeSe1CodeSe2CodeSe3CodeSe4CodeAe1CodeAe2CodeBe1CodeBe2CodeCe1CodeCe2CodeDe1CodeDe2CodeEe1CodeEe2CodeFe1CodeFe2CodeFe3CodeGe1CodeGe2CodeXe1CodeXe2"
-)
-
 var (
-	_Code_En_map = map[Code]string{
-		1:     _Code_En_name[0:6],
-		2:     _Code_En_name[6:13],
-		3:     _Code_En_name[13:21],
-		20001: _Code_En_name[21:31],
-		20002: _Code_En_name[31:41],
-		20003: _Code_En_name[41:51],
-		20004: _Code_En_name[51:61],
-		20204: _Code_En_name[61:71],
-		20205: _Code_En_name[71:81],
-		20206: _Code_En_name[81:91],
-		20301: _Code_En_name[91:101],
-		20302: _Code_En_name[101:112],
-		20310: _Code_En_name[112:119],
-		20311: _Code_En_name[119:126],
-		20400: _Code_En_name[126:133],
-		20401: _Code_En_name[133:140],
-		20410: _Code_En_name[140:147],
-		20411: _Code_En_name[147:154],
-		20420: _Code_En_name[154:161],
-		20421: _Code_En_name[161:168],
-		20430: _Code_En_name[168:175],
-		20431: _Code_En_name[175:182],
-		20440: _Code_En_name[182:189],
-		20441: _Code_En_name[189:196],
-		20450: _Code_En_name[196:203],
-		20451: _Code_En_name[203:210],
-		20460: _Code_En_name[210:217],
-		20461: _Code_En_name[217:224],
-		20470: _Code_En_name[224:231],
-		20471: _Code_En_name[231:238],
-		20472: _Code_En_name[238:245],
-		20480: _Code_En_name[245:252],
-		20481: _Code_En_name[252:259],
-		20490: _Code_En_name[259:266],
-		20491: _Code_En_name[266:273],
-	}
-	_Code_ZhHk_map = map[Code]string{
-		1:     _Code_ZhHk_name[0:6],
-		2:     _Code_ZhHk_name[6:13],
-		3:     _Code_ZhHk_name[13:21],
-		20001: _Code_ZhHk_name[21:31],
-		20002: _Code_ZhHk_name[31:41],
-		20003: _Code_ZhHk_name[41:51],
-		20004: _Code_ZhHk_name[51:61],
-		20204: _Code_ZhHk_name[61:71],
-		20205: _Code_ZhHk_name[71:81],
-		20206: _Code_ZhHk_name[81:91],
-		20301: _Code_ZhHk_name[91:101],
-		20302: _Code_ZhHk_name[101:112],
-		20310: _Code_ZhHk_name[112:119],
-		20311: _Code_ZhHk_name[119:126],
-		20400: _Code_ZhHk_name[126:133],
-		20401: _Code_ZhHk_name[133:140],
-		20410: _Code_ZhHk_name[140:147],
-		20411: _Code_ZhHk_name[147:154],
-		20420: _Code_ZhHk_name[154:161],
-		20421: _Code_ZhHk_name[161:168],
-		20430: _Code_ZhHk_name[168:175],
-		20431: _Code_ZhHk_name[175:182],
-		20440: _Code_ZhHk_name[182:189],
-		20441: _Code_ZhHk_name[189:196],
-		20450: _Code_ZhHk_name[196:203],
-		20451: _Code_ZhHk_name[203:210],
-		20460: _Code_ZhHk_name[210:217],
-		20461: _Code_ZhHk_name[217:224],
-		20470: _Code_ZhHk_name[224:231],
-		20471: _Code_ZhHk_name[231:238],
-		20472: _Code_ZhHk_name[238:245],
-		20480: _Code_ZhHk_name[245:252],
-		20481: _Code_ZhHk_name[252:259],
-		20490: _Code_ZhHk_name[259:266],
-		20491: _Code_ZhHk_name[266:273],
+	_Code_ids = [...][35]uint8{{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34}, {0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34}}
+	_Code_map = map[Code]uint8{
+		1:     0,
+		2:     1,
+		3:     2,
+		20001: 3,
+		20002: 4,
+		20003: 5,
+		20004: 6,
+		20204: 7,
+		20205: 8,
+		20206: 9,
+		20301: 10,
+		20302: 11,
+		20310: 12,
+		20311: 13,
+		20400: 14,
+		20401: 15,
+		20410: 16,
+		20411: 17,
+		20420: 18,
+		20421: 19,
+		20430: 20,
+		20431: 21,
+		20440: 22,
+		20441: 23,
+		20450: 24,
+		20451: 25,
+		20460: 26,
+		20461: 27,
+		20470: 28,
+		20471: 29,
+		20472: 30,
+		20480: 31,
+		20481: 32,
+		20490: 33,
+		20491: 34,
 	}
 )
 
-// _transOne translate one CONST
-func (i Code) _transOne(locale string) string {
-	switch locale {
-	case "en":
-		if str, ok := _Code_En_map[i]; ok {
-			return str
-		}
-		return "Code[" + locale + "](" + strconv.FormatInt(int64(i), 10) + ")"
-	case "zh-hk":
-		if str, ok := _Code_ZhHk_map[i]; ok {
-			return str
-		}
-		return "Code[" + locale + "](" + strconv.FormatInt(int64(i), 10) + ")"
-	default:
-		// Normally unreachable, should not happen but be cautious
-		return ""
+// _transIdx translate one CONST with locale index
+func (i Code) _transIdx(li int) string {
+	if n, ok := _Code_map[i]; ok {
+		return _Code_poolText(int(_Code_ids[li][n]))
 	}
+	return "Code[" + _Code_locales[li] + "](" + strconv.FormatInt(int64(i), 10) + ")"
 }
 
-// _Code_supported All supported locales record
+// _Code_locales All supported locales indexed by value of _Code_supported
+var _Code_locales = []string{"en", "zh-hk"}
+
+// _Code_supported All supported locales record, locale to index of _Code_locales
 var _Code_supported = map[string]int{"en": 0, "zh-hk": 1}
 
 // _Code_defaultLocale default locale
 // generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
 var _Code_defaultLocale = "en"
 
+// _Code_defaultIdx index of default locale in _Code_locales
+var _Code_defaultIdx = _Code_supported[_Code_defaultLocale]
+
 // _Code_ctxKey Key from context.Context Value get locale
 // generated pass by i18n-stringer flag -ctxkey, Don't assign directly
 var _Code_ctxKey = "i18nLocale"
@@ -169,7 +125,7 @@ var _Code_ctxKey = "i18nLocale"
 //   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
 //   - If you understand the above mechanism then you can use this method with confidence
 func (i Code) String() string {
-	return i._trans(_Code_defaultLocale)
+	return i._trans(_Code_defaultIdx)
 }
 
 // WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
@@ -178,7 +134,7 @@ func (i Code) String() string {
 //   - This method implements the error interface, so that you can return the value as an error,
 //   - If you understand the above mechanism then you can use this method with confidence
 func (i Code) Error() string {
-	return i._trans(_Code_defaultLocale)
+	return i._trans(_Code_defaultIdx)
 }
 
 // Code get original type int value
@@ -186,22 +142,31 @@ func (i Code) Code() int {
 	return int(i)
 }
 
-// Wrap another error with locale set for i18n TYPE Const
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
 //   - err another error
 //   - locale i18n locale name
 //   - args optional formatting component
 func (i Code) Wrap(err error, locale string, args ...interface{}) *I18nCodeErrorWrap {
+	i._mustNotFragment()
 	return &I18nCodeErrorWrap{err: err, origin: i, locale: locale, args: args}
 }
 
-// WrapWithContext wrap another error with context.Context set for i18n TYPE Const
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
 //   - ctx context with Value use Key from _Code_ctxKey, which pass by i18n-stringer flag -ctxkey
 //   - err another error
 //   - args optional formatting component
 func (i Code) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nCodeErrorWrap {
+	i._mustNotFragment()
 	return &I18nCodeErrorWrap{err: err, origin: i, locale: _Code_localeFromCtxWithFallback(ctx), args: args}
 }
 
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i Code) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("Code(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
 // I18nCodeErrorWrap type i18n error wrapper
 //
 //	WARNING
@@ -220,6 +185,17 @@ func (e *I18nCodeErrorWrap) Translate() string {
 	return e.origin.Trans(e.locale, e.args...)
 }
 
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nCodeErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
 // String implement fmt.Stringer, get translated string use Translate
 func (e *I18nCodeErrorWrap) String() string {
 	return e.Translate()
@@ -261,59 +237,246 @@ func (i Code) IsLocaleSupport(locale string) bool {
 
 // Lang get target translate text use context.Context
 //   - ctx  context with Value use Key from _Code_ctxKey, which pass by i18n-stringer flag -ctxkey
-//   - args Optional placeholder replacement value, value type of Code, or type of string
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
 func (i Code) Lang(ctx context.Context, args ...interface{}) string {
-	return i._trans(_Code_localeFromCtxWithFallback(ctx), args...)
+	return i._trans(_Code_localeIdxFromCtx(ctx), args...)
 }
 
 // Trans get target translate text use specified language locale identifier
 //   - locale specified language locale identifier, need pass by IsLocaleSupport
-//   - args Optional placeholder replacement value, value type of Code, or type of string
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
 func (i Code) Trans(locale string, args ...interface{}) string {
-	if !_Code_isLocaleSupport(locale) {
-		locale = _Code_defaultLocale
+	return i._trans(_Code_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i Code) LocaleIndex(locale string) int {
+	return _Code_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Code) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_Code_locales) {
+		li = _Code_defaultIdx
 	}
-	return i._trans(locale, args...)
+	return i._trans(li, args...)
 }
 
 func _Code_isLocaleSupport(locale string) bool {
-	_, ok := _Code_supported[locale]
-	return ok
+	return _Code_lookupLocale(locale) >= 0
+}
+
+// _Code_lookupLocale resolve language locale name to index of _Code_locales, -1 when it is not supported
+func _Code_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-hk":
+		return 1
+	}
+	return -1
+}
+
+// _Code_localeIdx resolve language locale name to index of _Code_locales.
+// It returns index of default locale when _Code_isLocaleSupport is false
+func _Code_localeIdx(locale string) int {
+	if li := _Code_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _Code_defaultIdx
+}
+
+// _Code_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _Code_locales.
+// It returns index of default locale when _Code_isLocaleSupport is false
+func _Code_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _Code_defaultIdx
+	}
+	if v, ok := ctx.Value(_Code_ctxKey).(string); ok {
+		return _Code_localeIdx(v)
+	}
+	return _Code_defaultIdx
 }
 
 // _Code_localeFromCtxWithFallback retrieves and returns language locale name from context.
 // It returns default locale when _Code_isLocaleSupport is false
 func _Code_localeFromCtxWithFallback(ctx context.Context) string {
-	if ctx == nil {
-		return _Code_defaultLocale
+	return _Code_locales[_Code_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i Code) IsFragment() bool {
+	return false
+}
+
+// _Code_hasVerbs whether any translation of Code has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _Code_hasVerbs = false
+
+// _Code_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _Code_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _Code_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _Code_fmt = [][][]_Code_seg{
+	{}, // en
+	{}, // zh-hk
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i Code) _segs(li int) []_Code_seg {
+	return nil
+}
+
+// _Code_bufPool buffers formatting translations with args
+var _Code_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _Code_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i Code) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i Code) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_Code_hasVerbs {
+		return msg
 	}
-	v := ctx.Value(_Code_ctxKey)
-	if v == nil {
-		return _Code_defaultLocale
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
 	}
-	if vv, ok := v.(string); ok && _Code_isLocaleSupport(vv) {
-		return vv
+	buf := _Code_bufPool.Get().(*[]byte)
+	*buf = _Code_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_Code_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Code) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _Code_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Code_hasVerbs {
+		return append(dst, msg...)
 	}
-	return _Code_defaultLocale
+	if segs := i._segs(li); segs != nil {
+		return _Code_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
 }
 
-// _trans trustworthy parameters inside method
-//   - locale i18n local
-//   - args   value type of Code, or type of string
-func (i Code) _trans(locale string, args ...interface{}) string {
-	msg := i._transOne(locale)
-	if len(args) > 0 {
-		var com []interface{}
-		for _, arg := range args {
-			if typ, ok := arg.(Code); ok {
-				com = append(com, typ._transOne(locale))
-			} else {
-				com = append(com, arg) // arg as string scalar
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Code) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _Code_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Code_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _Code_bufPool.Get().(*[]byte)
+	*buf = _Code_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_Code_bufPool.Put(buf)
+	return n, err
+}
+
+// _Code_sprintf format msg with args, args of type Code translated use locale index li
+func _Code_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _Code_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _Code_transArg translate arg use locale index li when arg is a value of Code, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _Code_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case Code:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_Code_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _Code_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _Code_sprintf
+func _Code_appendf(dst []byte, msg string, segs []_Code_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case Code:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_Code_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
 			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
 		}
-		return fmt.Sprintf(msg, com...)
+		n++
 	}
-	return msg
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _Code_sprintf(msg, li, args)...)
 }
 
 func _() {
@@ -328,58 +491,38 @@ func _() {
 	_ = x[TestCase06-1003]
 }
 
-const (
-	_Test_En_name_0   = "TestCase01TestCase02TestCase03"
-	_Test_ZhHk_name_0 = "TestCase01TestCase02TestCase03"
-	_Test_En_name_1   = "TestCase04TestCase05TestCase06"
-	_Test_ZhHk_name_1 = "TestCase04TestCase05TestCase06"
-)
-
 var (
-	_Test_En_index_0   = [...]uint8{0, 10, 20, 30}
-	_Test_ZhHk_index_0 = [...]uint8{0, 10, 20, 30}
-	_Test_En_index_1   = [...]uint8{0, 10, 20, 30}
-	_Test_ZhHk_index_1 = [...]uint8{0, 10, 20, 30}
+	_Test_ids_0 = [...][3]uint8{{35, 36, 37}, {35, 36, 37}}
+	_Test_ids_1 = [...][3]uint8{{38, 39, 40}, {38, 39, 40}}
 )
 
-// _transOne translate one CONST
-func (i Test) _transOne(locale string) string {
-	switch locale {
-	case "en":
-		switch {
-		case 10 <= i && i <= 12:
-			i -= 10
-			return _Test_En_name_0[_Test_En_index_0[i]:_Test_En_index_0[i+1]]
-		case 1001 <= i && i <= 1003:
-			i -= 1001
-			return _Test_En_name_1[_Test_En_index_1[i]:_Test_En_index_1[i+1]]
-		default:
-			return "Test[" + locale + "](" + strconv.FormatInt(int64(i), 10) + ")"
-		}
-	case "zh-hk":
-		switch {
-		case 10 <= i && i <= 12:
-			i -= 10
-			return _Test_ZhHk_name_0[_Test_ZhHk_index_0[i]:_Test_ZhHk_index_0[i+1]]
-		case 1001 <= i && i <= 1003:
-			i -= 1001
-			return _Test_ZhHk_name_1[_Test_ZhHk_index_1[i]:_Test_ZhHk_index_1[i+1]]
-		default:
-			return "Test[" + locale + "](" + strconv.FormatInt(int64(i), 10) + ")"
-		}
+// _transIdx translate one CONST with locale index
+func (i Test) _transIdx(li int) string {
+	switch {
+	case 10 <= i && i <= 12:
+		i -= 10
+		return _Code_poolText(int(_Test_ids_0[li][i]))
+	case 1001 <= i && i <= 1003:
+		i -= 1001
+		return _Code_poolText(int(_Test_ids_1[li][i]))
 	default:
-		// Normally unreachable, should not happen but be cautious
-		return ""
+		return "Test[" + _Test_locales[li] + "](" + strconv.FormatInt(int64(i), 10) + ")"
 	}
 }
 
-// _Test_supported All supported locales record
+// _Test_locales All supported locales indexed by value of _Test_supported
+var _Test_locales = []string{"en", "zh-hk"}
+
+// _Test_supported All supported locales record, locale to index of _Test_locales
 var _Test_supported = map[string]int{"en": 0, "zh-hk": 1}
 
 // _Test_defaultLocale default locale
 // generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
 var _Test_defaultLocale = "en"
 
+// _Test_defaultIdx index of default locale in _Test_locales
+var _Test_defaultIdx = _Test_supported[_Test_defaultLocale]
+
 // _Test_ctxKey Key from context.Context Value get locale
 // generated pass by i18n-stringer flag -ctxkey, Don't assign directly
 var _Test_ctxKey = "i18nLocale"
@@ -390,7 +533,7 @@ var _Test_ctxKey = "i18nLocale"
 //   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
 //   - If you understand the above mechanism then you can use this method with confidence
 func (i Test) String() string {
-	return i._trans(_Test_defaultLocale)
+	return i._trans(_Test_defaultIdx)
 }
 
 // WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
@@ -399,7 +542,7 @@ func (i Test) String() string {
 //   - This method implements the error interface, so that you can return the value as an error,
 //   - If you understand the above mechanism then you can use this method with confidence
 func (i Test) Error() string {
-	return i._trans(_Test_defaultLocale)
+	return i._trans(_Test_defaultIdx)
 }
 
 // Code get original type int value
@@ -407,22 +550,31 @@ func (i Test) Code() int {
 	return int(i)
 }
 
-// Wrap another error with locale set for i18n TYPE Const
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
 //   - err another error
 //   - locale i18n locale name
 //   - args optional formatting component
 func (i Test) Wrap(err error, locale string, args ...interface{}) *I18nTestErrorWrap {
+	i._mustNotFragment()
 	return &I18nTestErrorWrap{err: err, origin: i, locale: locale, args: args}
 }
 
-// WrapWithContext wrap another error with context.Context set for i18n TYPE Const
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
 //   - ctx context with Value use Key from _Test_ctxKey, which pass by i18n-stringer flag -ctxkey
 //   - err another error
 //   - args optional formatting component
 func (i Test) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nTestErrorWrap {
+	i._mustNotFragment()
 	return &I18nTestErrorWrap{err: err, origin: i, locale: _Test_localeFromCtxWithFallback(ctx), args: args}
 }
 
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i Test) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("Test(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
 // I18nTestErrorWrap type i18n error wrapper
 //
 //	WARNING
@@ -441,6 +593,17 @@ func (e *I18nTestErrorWrap) Translate() string {
 	return e.origin.Trans(e.locale, e.args...)
 }
 
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nTestErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
 // String implement fmt.Stringer, get translated string use Translate
 func (e *I18nTestErrorWrap) String() string {
 	return e.Translate()
@@ -482,59 +645,246 @@ func (i Test) IsLocaleSupport(locale string) bool {
 
 // Lang get target translate text use context.Context
 //   - ctx  context with Value use Key from _Test_ctxKey, which pass by i18n-stringer flag -ctxkey
-//   - args Optional placeholder replacement value, value type of Test, or type of string
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
 func (i Test) Lang(ctx context.Context, args ...interface{}) string {
-	return i._trans(_Test_localeFromCtxWithFallback(ctx), args...)
+	return i._trans(_Test_localeIdxFromCtx(ctx), args...)
 }
 
 // Trans get target translate text use specified language locale identifier
 //   - locale specified language locale identifier, need pass by IsLocaleSupport
-//   - args Optional placeholder replacement value, value type of Test, or type of string
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
 func (i Test) Trans(locale string, args ...interface{}) string {
-	if !_Test_isLocaleSupport(locale) {
-		locale = _Test_defaultLocale
+	return i._trans(_Test_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i Test) LocaleIndex(locale string) int {
+	return _Test_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Test) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_Test_locales) {
+		li = _Test_defaultIdx
 	}
-	return i._trans(locale, args...)
+	return i._trans(li, args...)
 }
 
 func _Test_isLocaleSupport(locale string) bool {
-	_, ok := _Test_supported[locale]
-	return ok
+	return _Test_lookupLocale(locale) >= 0
+}
+
+// _Test_lookupLocale resolve language locale name to index of _Test_locales, -1 when it is not supported
+func _Test_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-hk":
+		return 1
+	}
+	return -1
+}
+
+// _Test_localeIdx resolve language locale name to index of _Test_locales.
+// It returns index of default locale when _Test_isLocaleSupport is false
+func _Test_localeIdx(locale string) int {
+	if li := _Test_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _Test_defaultIdx
+}
+
+// _Test_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _Test_locales.
+// It returns index of default locale when _Test_isLocaleSupport is false
+func _Test_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _Test_defaultIdx
+	}
+	if v, ok := ctx.Value(_Test_ctxKey).(string); ok {
+		return _Test_localeIdx(v)
+	}
+	return _Test_defaultIdx
 }
 
 // _Test_localeFromCtxWithFallback retrieves and returns language locale name from context.
 // It returns default locale when _Test_isLocaleSupport is false
 func _Test_localeFromCtxWithFallback(ctx context.Context) string {
-	if ctx == nil {
-		return _Test_defaultLocale
+	return _Test_locales[_Test_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i Test) IsFragment() bool {
+	return false
+}
+
+// _Test_hasVerbs whether any translation of Test has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _Test_hasVerbs = false
+
+// _Test_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _Test_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _Test_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _Test_fmt = [][][]_Test_seg{
+	{}, // en
+	{}, // zh-hk
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i Test) _segs(li int) []_Test_seg {
+	return nil
+}
+
+// _Test_bufPool buffers formatting translations with args
+var _Test_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _Test_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i Test) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i Test) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_Test_hasVerbs {
+		return msg
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
+	}
+	buf := _Test_bufPool.Get().(*[]byte)
+	*buf = _Test_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_Test_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Test) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _Test_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Test_hasVerbs {
+		return append(dst, msg...)
 	}
-	v := ctx.Value(_Test_ctxKey)
-	if v == nil {
-		return _Test_defaultLocale
+	if segs := i._segs(li); segs != nil {
+		return _Test_appendf(dst, msg, segs, li, args)
 	}
-	if vv, ok := v.(string); ok && _Test_isLocaleSupport(vv) {
-		return vv
+	return append(dst, msg...)
+}
+
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Test) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _Test_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Test_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
 	}
-	return _Test_defaultLocale
+	buf := _Test_bufPool.Get().(*[]byte)
+	*buf = _Test_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_Test_bufPool.Put(buf)
+	return n, err
 }
 
-// _trans trustworthy parameters inside method
-//   - locale i18n local
-//   - args   value type of Test, or type of string
-func (i Test) _trans(locale string, args ...interface{}) string {
-	msg := i._transOne(locale)
-	if len(args) > 0 {
-		var com []interface{}
-		for _, arg := range args {
-			if typ, ok := arg.(Test); ok {
-				com = append(com, typ._transOne(locale))
-			} else {
-				com = append(com, arg) // arg as string scalar
+// _Test_sprintf format msg with args, args of type Test translated use locale index li
+func _Test_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _Test_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _Test_transArg translate arg use locale index li when arg is a value of Test, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _Test_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case Test:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_Test_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _Test_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _Test_sprintf
+func _Test_appendf(dst []byte, msg string, segs []_Test_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case Test:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_Test_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
 			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
 		}
-		return fmt.Sprintf(msg, com...)
+		n++
 	}
-	return msg
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _Test_sprintf(msg, li, args)...)
 }
 
 func _() {
@@ -567,41 +917,32 @@ func _() {
 	_ = x[Sig24-323]
 }
 
-const (
-	_Single_En_name   = "Sig01Sig02Sig03Sig04Sig05Sig06Sig07Sig08Sig09Sig10Sig11Sig12Sig13Sig14Sig15Sig16Sig17Sig18Sig19Sig20Sig21Sig22Sig23Sig24"
-	_Single_ZhHk_name = "Sig01Sig02Sig03Sig04Sig05Sig06Sig07Sig08Sig09Sig10Sig11Sig12Sig13Sig14Sig15Sig16Sig17Sig18Sig19Sig20Sig21Sig22Sig23Sig24"
-)
-
 var (
-	_Single_En_index   = [...]uint8{0, 5, 10, 15, 20, 25, 30, 35, 40, 45, 50, 55, 60, 65, 70, 75, 80, 85, 90, 95, 100, 105, 110, 115, 120}
-	_Single_ZhHk_index = [...]uint8{0, 5, 10, 15, 20, 25, 30, 35, 40, 45, 50, 55, 60, 65, 70, 75, 80, 85, 90, 95, 100, 105, 110, 115, 120}
+	_Single_ids = [...][24]uint8{{41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64}, {41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64}}
 )
 
-// _transOne translate one CONST
-func (i Single) _transOne(locale string) string {
+// _transIdx translate one CONST with locale index
+func (i Single) _transIdx(li int) string {
 	i -= 300
-	if i < 0 || i >= Single(len(_Single_En_index)-1) {
-		return "Single[" + locale + "](" + strconv.FormatInt(int64(i), 10) + ")"
-	}
-
-	switch locale {
-	case "en":
-		return _Single_En_name[_Single_En_index[i]:_Single_En_index[i+1]]
-	case "zh-hk":
-		return _Single_ZhHk_name[_Single_ZhHk_index[i]:_Single_ZhHk_index[i+1]]
-	default:
-		// Normally unreachable, should not happen but be cautious
-		return ""
+	if i < 0 || i >= Single(len(_Single_ids[0])) {
+		return "Single[" + _Single_locales[li] + "](" + strconv.FormatInt(int64(i+300), 10) + ")"
 	}
+	return _Code_poolText(int(_Single_ids[li][i]))
 }
 
-// _Single_supported All supported locales record
+// _Single_locales All supported locales indexed by value of _Single_supported
+var _Single_locales = []string{"en", "zh-hk"}
+
+// _Single_supported All supported locales record, locale to index of _Single_locales
 var _Single_supported = map[string]int{"en": 0, "zh-hk": 1}
 
 // _Single_defaultLocale default locale
 // generated pass by i18n-stringer flag -defaultlocale, Don't assign directly
 var _Single_defaultLocale = "en"
 
+// _Single_defaultIdx index of default locale in _Single_locales
+var _Single_defaultIdx = _Single_supported[_Single_defaultLocale]
+
 // _Single_ctxKey Key from context.Context Value get locale
 // generated pass by i18n-stringer flag -ctxkey, Don't assign directly
 var _Single_ctxKey = "i18nLocale"
@@ -612,7 +953,7 @@ var _Single_ctxKey = "i18nLocale"
 //   - This method implements the fmt.Stringer interface, so that you can output it directly by package fmt,
 //   - If you understand the above mechanism then you can use this method with confidence
 func (i Single) String() string {
-	return i._trans(_Single_defaultLocale)
+	return i._trans(_Single_defaultIdx)
 }
 
 // WARNING: You should use Trans, Lang, Wrap, WrapWithContext method instead
@@ -621,7 +962,7 @@ func (i Single) String() string {
 //   - This method implements the error interface, so that you can return the value as an error,
 //   - If you understand the above mechanism then you can use this method with confidence
 func (i Single) Error() string {
-	return i._trans(_Single_defaultLocale)
+	return i._trans(_Single_defaultIdx)
 }
 
 // Code get original type int value
@@ -629,22 +970,31 @@ func (i Single) Code() int {
 	return int(i)
 }
 
-// Wrap another error with locale set for i18n TYPE Const
+// Wrap another error with locale set for i18n TYPE Const, panics when i is a placeholder fragment
 //   - err another error
 //   - locale i18n locale name
 //   - args optional formatting component
 func (i Single) Wrap(err error, locale string, args ...interface{}) *I18nSingleErrorWrap {
+	i._mustNotFragment()
 	return &I18nSingleErrorWrap{err: err, origin: i, locale: locale, args: args}
 }
 
-// WrapWithContext wrap another error with context.Context set for i18n TYPE Const
+// WrapWithContext wrap another error with context.Context set for i18n TYPE Const, panics when i is a placeholder fragment
 //   - ctx context with Value use Key from _Single_ctxKey, which pass by i18n-stringer flag -ctxkey
 //   - err another error
 //   - args optional formatting component
 func (i Single) WrapWithContext(ctx context.Context, err error, args ...interface{}) *I18nSingleErrorWrap {
+	i._mustNotFragment()
 	return &I18nSingleErrorWrap{err: err, origin: i, locale: _Single_localeFromCtxWithFallback(ctx), args: args}
 }
 
+// _mustNotFragment panics when i is a placeholder fragment, which is not an error code
+func (i Single) _mustNotFragment() {
+	if i.IsFragment() {
+		panic("Single(" + strconv.FormatInt(int64(i), 10) + ") is a placeholder fragment, it can not be wrapped as an error")
+	}
+}
+
 // I18nSingleErrorWrap type i18n error wrapper
 //
 //	WARNING
@@ -663,6 +1013,17 @@ func (e *I18nSingleErrorWrap) Translate() string {
 	return e.origin.Trans(e.locale, e.args...)
 }
 
+// Trans get translated string use specified language locale identifier instead of the wrapped one,
+// so that the wrapper used as an arg of other generated types is translated in their locale
+//   - locale specified language locale identifier
+//   - args   Optional placeholder replacement value, the wrapped args are used when not given
+func (e *I18nSingleErrorWrap) Trans(locale string, args ...interface{}) string {
+	if len(args) == 0 {
+		args = e.args
+	}
+	return e.origin.Trans(locale, args...)
+}
+
 // String implement fmt.Stringer, get translated string use Translate
 func (e *I18nSingleErrorWrap) String() string {
 	return e.Translate()
@@ -704,57 +1065,255 @@ func (i Single) IsLocaleSupport(locale string) bool {
 
 // Lang get target translate text use context.Context
 //   - ctx  context with Value use Key from _Single_ctxKey, which pass by i18n-stringer flag -ctxkey
-//   - args Optional placeholder replacement value, value type of Single, or type of string
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
 func (i Single) Lang(ctx context.Context, args ...interface{}) string {
-	return i._trans(_Single_localeFromCtxWithFallback(ctx), args...)
+	return i._trans(_Single_localeIdxFromCtx(ctx), args...)
 }
 
 // Trans get target translate text use specified language locale identifier
 //   - locale specified language locale identifier, need pass by IsLocaleSupport
-//   - args Optional placeholder replacement value, value type of Single, or type of string
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
 func (i Single) Trans(locale string, args ...interface{}) string {
-	if !_Single_isLocaleSupport(locale) {
-		locale = _Single_defaultLocale
+	return i._trans(_Single_localeIdx(locale), args...)
+}
+
+// LocaleIndex resolve the specified language locale identifier to its index once, for TransIndex
+//   - locale specified language locale identifier
+//   - returns -1 when the locale is not supported
+func (i Single) LocaleIndex(locale string) int {
+	return _Single_lookupLocale(locale)
+}
+
+// TransIndex get target translate text use language locale index resolved by LocaleIndex
+//   - li   language locale index, default locale used when invalid
+//   - args Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Single) TransIndex(li int, args ...interface{}) string {
+	if li < 0 || li >= len(_Single_locales) {
+		li = _Single_defaultIdx
 	}
-	return i._trans(locale, args...)
+	return i._trans(li, args...)
 }
 
 func _Single_isLocaleSupport(locale string) bool {
-	_, ok := _Single_supported[locale]
-	return ok
+	return _Single_lookupLocale(locale) >= 0
+}
+
+// _Single_lookupLocale resolve language locale name to index of _Single_locales, -1 when it is not supported
+func _Single_lookupLocale(locale string) int {
+	switch locale {
+	case "en":
+		return 0
+	case "zh-hk":
+		return 1
+	}
+	return -1
+}
+
+// _Single_localeIdx resolve language locale name to index of _Single_locales.
+// It returns index of default locale when _Single_isLocaleSupport is false
+func _Single_localeIdx(locale string) int {
+	if li := _Single_lookupLocale(locale); li >= 0 {
+		return li
+	}
+	return _Single_defaultIdx
+}
+
+// _Single_localeIdxFromCtx retrieves language locale name from context and resolve it to index of _Single_locales.
+// It returns index of default locale when _Single_isLocaleSupport is false
+func _Single_localeIdxFromCtx(ctx context.Context) int {
+	if ctx == nil {
+		return _Single_defaultIdx
+	}
+	if v, ok := ctx.Value(_Single_ctxKey).(string); ok {
+		return _Single_localeIdx(v)
+	}
+	return _Single_defaultIdx
 }
 
 // _Single_localeFromCtxWithFallback retrieves and returns language locale name from context.
 // It returns default locale when _Single_isLocaleSupport is false
 func _Single_localeFromCtxWithFallback(ctx context.Context) string {
-	if ctx == nil {
-		return _Single_defaultLocale
+	return _Single_locales[_Single_localeIdxFromCtx(ctx)]
+}
+
+// IsFragment report whether i is a placeholder fragment constant, which is only used as
+// replacement value of other translations and can not be wrapped as an error
+func (i Single) IsFragment() bool {
+	return false
+}
+
+// _Single_hasVerbs whether any translation of Single has a fmt verb, translations
+// without verbs are returned as they are, whatever args are given
+const _Single_hasVerbs = false
+
+// _Single_seg one segment of a translation with fmt verbs precompiled by i18n-stringer, the literal
+// msg[lo:hi] followed by verb, 's' 'v' or 'd' formatting the next arg, '%' a percent sign, 0 none,
+// or '!' the whole translation formatted by fmt.Sprintf
+type _Single_seg struct {
+	lo, hi uint16
+	verb   byte
+}
+
+// _Single_fmt segments of the translations with fmt verbs indexed by locale index, then by constant, see _segs
+var _Single_fmt = [][][]_Single_seg{
+	{}, // en
+	{}, // zh-hk
+}
+
+// _segs returns the segments of the translation of i in locale index li, nil without fmt verbs
+func (i Single) _segs(li int) []_Single_seg {
+	return nil
+}
+
+// _Single_bufPool buffers formatting translations with args
+var _Single_bufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// _trans trustworthy parameters inside method
+//   - li   i18n locale index of _Single_locales
+//   - args value of any type generated by i18n-stringer, or type of string
+func (i Single) _trans(li int, args ...interface{}) string {
+	return i._format(i._transIdx(li), li, args)
+}
+
+// _format returns msg, the translation of i in locale index li, formatted with args by its segments
+func (i Single) _format(msg string, li int, args []interface{}) string {
+	if len(args) == 0 || !_Single_hasVerbs {
+		return msg
 	}
-	v := ctx.Value(_Single_ctxKey)
-	if v == nil {
-		return _Single_defaultLocale
+	segs := i._segs(li)
+	if segs == nil {
+		return msg
 	}
-	if vv, ok := v.(string); ok && _Single_isLocaleSupport(vv) {
-		return vv
+	buf := _Single_bufPool.Get().(*[]byte)
+	*buf = _Single_appendf((*buf)[:0], msg, segs, li, args)
+	msg = string(*buf)
+	_Single_bufPool.Put(buf)
+	return msg
+}
+
+// AppendTrans append translate text use specified language locale identifier to dst and return the extended buffer,
+// translations are appended without any allocation unless fmt.Sprintf formats them
+//   - dst    buffer to append to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Single) AppendTrans(dst []byte, locale string, args ...interface{}) []byte {
+	li := _Single_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Single_hasVerbs {
+		return append(dst, msg...)
 	}
-	return _Single_defaultLocale
+	if segs := i._segs(li); segs != nil {
+		return _Single_appendf(dst, msg, segs, li, args)
+	}
+	return append(dst, msg...)
 }
 
-// _trans trustworthy parameters inside method
-//   - locale i18n local
-//   - args   value type of Single, or type of string
-func (i Single) _trans(locale string, args ...interface{}) string {
-	msg := i._transOne(locale)
-	if len(args) > 0 {
-		var com []interface{}
-		for _, arg := range args {
-			if typ, ok := arg.(Single); ok {
-				com = append(com, typ._transOne(locale))
-			} else {
-				com = append(com, arg) // arg as string scalar
+// WriteTrans write translate text use specified language locale identifier to w, translations are
+// written without any allocation unless fmt.Sprintf formats them, when w is an io.StringWriter
+//   - w      writer to write to
+//   - locale specified language locale identifier, default locale used when not supported
+//   - args   Optional placeholder replacement value, value of any type generated by i18n-stringer, or type of string
+func (i Single) WriteTrans(w io.Writer, locale string, args ...interface{}) (int, error) {
+	li := _Single_localeIdx(locale)
+	msg := i._transIdx(li)
+	if len(args) == 0 || !_Single_hasVerbs {
+		return io.WriteString(w, msg)
+	}
+	segs := i._segs(li)
+	if segs == nil {
+		return io.WriteString(w, msg)
+	}
+	buf := _Single_bufPool.Get().(*[]byte)
+	*buf = _Single_appendf((*buf)[:0], msg, segs, li, args)
+	n, err := w.Write(*buf)
+	_Single_bufPool.Put(buf)
+	return n, err
+}
+
+// _Single_sprintf format msg with args, args of type Single translated use locale index li
+func _Single_sprintf(msg string, li int, args []interface{}) string {
+	com := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		com = append(com, _Single_transArg(arg, li))
+	}
+	return fmt.Sprintf(msg, com...)
+}
+
+// _Single_transArg translate arg use locale index li when arg is a value of Single, a value of any other
+// type generated by i18n-stringer or its error wrapper, which are translated in the same locale.
+// Any other arg is left to fmt, so that fmt.Stringer and error values use their own String or Error.
+func _Single_transArg(arg interface{}, li int) interface{} {
+	switch typ := arg.(type) {
+	case Single:
+		return typ._trans(li)
+	case interface {
+		Trans(locale string, args ...interface{}) string
+	}:
+		return typ.Trans(_Single_locales[li])
+	}
+	return arg // arg as string scalar
+}
+
+// _Single_appendf append msg formatted with args by its segments segs to dst, the verbs %s %v of string or
+// generated types and %d %v of int are formatted in place, anything else falls back to _Single_sprintf
+func _Single_appendf(dst []byte, msg string, segs []_Single_seg, li int, args []interface{}) []byte {
+	start, n := len(dst), 0
+	for _, seg := range segs {
+		dst = append(dst, msg[seg.lo:seg.hi]...)
+		switch seg.verb {
+		case 0:
+			continue
+		case '%':
+			dst = append(dst, '%')
+			continue
+		case '!':
+			goto fallback
+		}
+		if n == len(args) {
+			goto fallback
+		}
+		switch arg := args[n].(type) {
+		case Single:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg._trans(li)...)
+		case interface {
+			Trans(locale string, args ...interface{}) string
+		}:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg.Trans(_Single_locales[li])...)
+		case string:
+			if seg.verb == 'd' {
+				goto fallback
+			}
+			dst = append(dst, arg...)
+		case int:
+			if seg.verb == 's' {
+				goto fallback
 			}
+			dst = strconv.AppendInt(dst, int64(arg), 10)
+		default:
+			goto fallback
 		}
-		return fmt.Sprintf(msg, com...)
+		n++
 	}
-	return msg
+	if n == len(args) {
+		return dst
+	}
+fallback:
+	return append(dst[:start], _Single_sprintf(msg, li, args)...)
+}
+
+// _Code_pool deduplicated texts shared by all types and locales in this file
+const _Code_pool = "CodeOKCodeErrCodeFailCodeRange1CodeRange2CodeRange3CodeRange4CodeRange5CodeRange6CodeRange7CodeRange9CodeRange10CodeTe1CodeTe2CodeSe1CodeSe2CodeSe3CodeSe4CodeAe1CodeAe2CodeBe1CodeBe2CodeCe1CodeCe2CodeDe1CodeDe2CodeEe1CodeEe2CodeFe1CodeFe2CodeFe3CodeGe1CodeGe2CodeXe1CodeXe2TestCase01TestCase02TestCase03TestCase04TestCase05TestCase06Sig01Sig02Sig03Sig04Sig05Sig06Sig07Sig08Sig09Sig10Sig11Sig12Sig13Sig14Sig15Sig16Sig17Sig18Sig19Sig20Sig21Sig22Sig23Sig24"
+
+// _Code_pool_index boundaries of each text in _Code_pool
+var _Code_pool_index = [...]uint16{0, 6, 13, 21, 31, 41, 51, 61, 71, 81, 91, 101, 112, 119, 126, 133, 140, 147, 154, 161, 168, 175, 182, 189, 196, 203, 210, 217, 224, 231, 238, 245, 252, 259, 266, 273, 283, 293, 303, 313, 323, 333, 338, 343, 348, 353, 358, 363, 368, 373, 378, 383, 388, 393, 398, 403, 408, 413, 418, 423, 428, 433, 438, 443, 448, 453}
+
+// _Code_poolText get text by id from _Code_pool
+func _Code_poolText(id int) string {
+	return _Code_pool[_Code_pool_index[id]:_Code_pool_index[id+1]]
 }